@@ -0,0 +1,484 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"cloud.google.com/go/logging/apiv2/loggingpb"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zchee/zapcl/pkg/monitoredresource"
+)
+
+const (
+	// defaultAPITimeout is the default timeout of the WriteLogEntries call.
+	defaultAPITimeout = 10 * time.Second
+
+	// defaultSyncTimeout is the default deadline of flushing the buffered log entries on Sync.
+	defaultSyncTimeout = 5 * time.Second
+)
+
+// ErrNoProjectID is returned by APICore.Write if the project ID of the log name is neither configured nor detected.
+var ErrNoProjectID = errors.New("zapcl: no project ID of the log name")
+
+// APICore represents a zapcore.Core that writes the log entries directly to the Cloud Logging API
+// instead of serializing JSON to the stdout.
+//
+// It is useful on the hosts which have no logging agent to scrape the stdout, such as on-prem or batch machines.
+type APICore struct {
+	zapcore.LevelEnabler
+
+	client      loggingpb.LoggingServiceV2Client
+	res         *monitoredresource.MonitoredResource
+	projectID   string
+	logID       string
+	timeout     time.Duration
+	syncTimeout time.Duration
+	bundle      bundlerConfig
+	bundler     *bundler
+	fields      []zapcore.Field
+	errorHook   func(error)
+
+	// err is the configuration error which makes the log entries unwritable
+	err error
+}
+
+var _ zapcore.Core = (*APICore)(nil)
+
+// APIOption configures an APICore.
+type APIOption interface {
+	applyAPI(*APICore)
+}
+
+// apiOptionFunc wraps a func so it satisfies the APIOption interface.
+type apiOptionFunc func(*APICore)
+
+func (f apiOptionFunc) applyAPI(c *APICore) {
+	f(c)
+}
+
+// WithAPIResource configures the MonitoredResource of the written log entries.
+//
+// If not set, the resource is detected by monitoredresource.Detect.
+func WithAPIResource(res *monitoredresource.MonitoredResource) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.res = res
+	})
+}
+
+// WithAPIProjectID configures the project ID of the log name.
+//
+// If not set, the "project_id" label of the MonitoredResource is used.
+func WithAPIProjectID(projectID string) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.projectID = projectID
+	})
+}
+
+// WithAPILogID configures the log ID of the log name, such as "stdout" or "my-app".
+//
+// If not set, the LogID of the MonitoredResource is used.
+func WithAPILogID(logID string) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.logID = logID
+	})
+}
+
+// WithAPIErrorHook configures the hook which is called with the configuration error of NewAPICore, such as
+// ErrNoProjectID, and the error of the monitored resource detection.
+func WithAPIErrorHook(hook func(error)) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.errorHook = hook
+	})
+}
+
+// WithAPITimeout configures the timeout of the WriteLogEntries call.
+func WithAPITimeout(timeout time.Duration) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.timeout = timeout
+	})
+}
+
+// WithAPISyncTimeout configures the deadline of flushing the buffered log entries on Sync.
+func WithAPISyncTimeout(timeout time.Duration) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.syncTimeout = timeout
+	})
+}
+
+// WithAPIBundleCountThreshold configures the number of log entries which triggers a WriteLogEntries request.
+//
+// Default is 1000.
+func WithAPIBundleCountThreshold(n int) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.bundle.countThreshold = n
+	})
+}
+
+// WithAPIBundleByteThreshold configures the total size of log entries which triggers a WriteLogEntries request.
+//
+// Default is 9 MiB. It is capped to 10 MB, the size limit of the WriteLogEntries request.
+func WithAPIBundleByteThreshold(n int) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.bundle.byteThreshold = n
+	})
+}
+
+// WithAPIBundleDelayThreshold configures the maximum time to buffer the log entries before a WriteLogEntries request.
+//
+// Default is 1 second.
+func WithAPIBundleDelayThreshold(d time.Duration) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.bundle.delayThreshold = d
+	})
+}
+
+// WithAPIBufferedEntryLimit configures the maximum number of pending log entries, and the OverflowPolicy applied when it is reached.
+//
+// Default is 10000 entries and OverflowBlock.
+func WithAPIBufferedEntryLimit(n int, policy OverflowPolicy) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.bundle.bufferLimit = n
+		c.bundle.overflow = policy
+	})
+}
+
+// WithAPIRetry configures the exponential backoff of retrying the WriteLogEntries request
+// failed with the retryable gRPC codes: DeadlineExceeded, Internal, Unavailable and ResourceExhausted.
+//
+// Default is 100 milliseconds initial, 5 seconds max backoff and 5 attempts.
+func WithAPIRetry(initialBackoff, maxBackoff time.Duration, maxAttempts int) APIOption {
+	return apiOptionFunc(func(c *APICore) {
+		c.bundle.initialBackoff = initialBackoff
+		c.bundle.maxBackoff = maxBackoff
+		c.bundle.maxAttempts = maxAttempts
+	})
+}
+
+// NewAPICore creates an APICore that writes logs to the Cloud Logging API through client.
+//
+// The log entries are buffered and written in the background by bundles. Call Sync, or Close before the process
+// exits so that the buffered log entries are not lost.
+//
+// The client is typically created by loggingpb.NewLoggingServiceV2Client with a grpc.ClientConn
+// connected to "logging.googleapis.com:443" with the Google credentials.
+//
+// If the project ID is neither configured by WithAPIProjectID nor the "project_id" label of the MonitoredResource,
+// ErrNoProjectID is reported to the hook of WithAPIErrorHook, and Write returns it instead of buffering the log
+// entries which the Cloud Logging API rejects.
+func NewAPICore(client loggingpb.LoggingServiceV2Client, enab zapcore.LevelEnabler, opts ...APIOption) zapcore.Core {
+	core := &APICore{
+		LevelEnabler: enab,
+		client:       client,
+		timeout:      defaultAPITimeout,
+		syncTimeout:  defaultSyncTimeout,
+		bundle:       defaultBundlerConfig(),
+	}
+	for _, opt := range opts {
+		opt.applyAPI(core)
+	}
+
+	if core.res == nil {
+		core.res = monitoredresource.Detect(monitoredresource.WithErrorHook(core.errorHook))
+	}
+	if core.projectID == "" {
+		core.projectID = core.res.GetLabels()["project_id"]
+	}
+	if core.projectID == "" {
+		core.err = fmt.Errorf("%w: configure WithAPIProjectID or the project_id label of the monitored resource", ErrNoProjectID)
+		if core.errorHook != nil {
+			core.errorHook(core.err)
+		}
+	}
+	if core.logID == "" {
+		core.logID = core.res.LogID
+	}
+	core.bundler = newBundler(core.bundle, core.writeLogEntries)
+
+	return core
+}
+
+// logName returns the resource name of the log to which the log entries are written.
+func (c *APICore) logName() string {
+	logID := c.logID
+	if unescaped, err := url.PathUnescape(logID); err == nil {
+		logID = unescaped
+	}
+
+	return "projects/" + c.projectID + "/logs/" + url.PathEscape(logID)
+}
+
+// With adds structured context to the APICore.
+//
+// With implements zapcore.Core.With.
+func (c *APICore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.fields = make([]zapcore.Field, 0, len(c.fields)+len(fields))
+	clone.fields = append(clone.fields, c.fields...)
+	clone.fields = append(clone.fields, fields...)
+
+	return &clone
+}
+
+// Check determines whether the supplied Entry should be logged.
+//
+// Check implements zapcore.Core.Check.
+func (c *APICore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+
+	return ce
+}
+
+// Write converts the Entry and any Fields supplied at the log site into the LogEntry and
+// adds it to the buffer which is written to the Cloud Logging API in the background.
+//
+// Write implements zapcore.Core.Write.
+func (c *APICore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	entry, err := c.newLogEntry(ent, fields)
+	if err != nil {
+		return fmt.Errorf("could not convert entry: %w", err)
+	}
+
+	c.bundler.add(entry)
+
+	if ent.Level > zapcore.ErrorLevel {
+		// Since we may be crashing the program, flush the buffered log entries.
+		c.Sync() //nolint:errcheck
+	}
+
+	return nil
+}
+
+// Sync flushes the buffered log entries with the deadline configured by WithAPISyncTimeout.
+//
+// It returns *DroppedEntriesError if any log entries were dropped since the last Sync.
+//
+// Sync implements zapcore.Core.Sync.
+func (c *APICore) Sync() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.syncTimeout)
+	defer cancel()
+
+	return c.result(c.bundler.flush(ctx))
+}
+
+// Close flushes the buffered log entries with the deadline configured by WithAPISyncTimeout,
+// and stops the background goroutine. The log entries written after Close are dropped.
+//
+// It returns *DroppedEntriesError if any log entries were dropped since the last Sync.
+func (c *APICore) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.syncTimeout)
+	defer cancel()
+
+	return c.result(c.bundler.close(ctx))
+}
+
+func (c *APICore) result(flushErr error) error {
+	dropped, err := c.bundler.stats()
+	if flushErr != nil {
+		flushErr = fmt.Errorf("could not flush log entries: %w", flushErr)
+	}
+	if dropped > 0 {
+		return &DroppedEntriesError{
+			Dropped: dropped,
+			Err:     errors.Join(flushErr, err),
+		}
+	}
+
+	return flushErr
+}
+
+// writeLogEntries writes entries to the Cloud Logging API.
+func (c *APICore) writeLogEntries(entries []*loggingpb.LogEntry) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	req := &loggingpb.WriteLogEntriesRequest{
+		LogName:        c.logName(),
+		Resource:       c.res.MonitoredResource,
+		Entries:        entries,
+		PartialSuccess: true,
+	}
+	if _, err := c.client.WriteLogEntries(ctx, req); err != nil {
+		return fmt.Errorf("could not write log entries: %w", err)
+	}
+
+	return nil
+}
+
+// newLogEntry converts ent and fields into the LogEntry.
+//
+// The Cloud Logging special fields are lifted into their proper LogEntry fields, and the remaining fields are
+// encoded into the jsonPayload.
+func (c *APICore) newLogEntry(ent zapcore.Entry, fields []zapcore.Field) (*loggingpb.LogEntry, error) {
+	cfg := NewEncoderConfig()
+	entry := &loggingpb.LogEntry{
+		Timestamp: timestamppb.New(ent.Time),
+		Severity:  levelToSeverity[ent.Level],
+	}
+
+	enc := zapcore.NewMapObjectEncoder()
+	enc.AddString(cfg.MessageKey, ent.Message)
+	if ent.LoggerName != "" {
+		enc.AddString(cfg.NameKey, ent.LoggerName)
+	}
+	if ent.Caller.Defined {
+		enc.AddString(cfg.CallerKey, ent.Caller.TrimmedPath())
+	}
+	if ent.Stack != "" {
+		enc.AddString(cfg.StacktraceKey, ent.Stack)
+	}
+
+	for _, fs := range [][]zapcore.Field{c.fields, fields} {
+		for i := range fs {
+			if liftField(entry, fs[i]) {
+				if labels, ok := labelsFromField(fs[i]); ok && labels.err != nil {
+					enc.AddString(LabelsKey+"Error", labels.err.Error())
+				}
+				continue
+			}
+			fs[i].AddTo(enc)
+		}
+	}
+
+	payload, err := structpb.NewStruct(toStructValue(enc.Fields).(map[string]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("could not convert payload: %w", err)
+	}
+	entry.Payload = &loggingpb.LogEntry_JsonPayload{JsonPayload: payload}
+
+	return entry, nil
+}
+
+// liftField sets the Cloud Logging special field into its proper LogEntry field, and reports whether the field was lifted.
+func liftField(entry *loggingpb.LogEntry, field zapcore.Field) bool {
+	switch field.Key {
+	case HTTPRequestKey:
+		if p, ok := field.Interface.(*HTTPPayload); ok && p != nil {
+			entry.HttpRequest = p.HttpRequest
+			return true
+		}
+
+	case OperationKey:
+		if op, ok := field.Interface.(*operation); ok && op != nil {
+			entry.Operation = op.LogEntryOperation
+			return true
+		}
+
+	case SourceLocationKey:
+		if loc, ok := field.Interface.(*sourceLocation); ok && loc != nil {
+			entry.SourceLocation = loc.LogEntrySourceLocation
+			return true
+		}
+
+	case LabelsKey:
+		if labels, ok := labelsFromField(field); ok {
+			if entry.Labels == nil {
+				entry.Labels = make(map[string]string, len(labels.m))
+			}
+			for key, val := range labels.m {
+				entry.Labels[key] = val
+			}
+			return true
+		}
+
+	case InsertIDKey:
+		if field.Type == zapcore.StringType {
+			entry.InsertId = field.String
+			return true
+		}
+
+	case TraceKey:
+		if field.Type == zapcore.StringType {
+			entry.Trace = field.String
+			return true
+		}
+
+	case SpanKey:
+		if field.Type == zapcore.StringType {
+			entry.SpanId = field.String
+			return true
+		}
+
+	case TraceSampledKey:
+		if field.Type == zapcore.BoolType {
+			entry.TraceSampled = field.Integer == 1
+			return true
+		}
+	}
+
+	return false
+}
+
+// toStructValue converts the values encoded by zapcore.MapObjectEncoder into the types supported by structpb.NewValue.
+func toStructValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil, bool, int, int32, int64, uint, uint32, uint64, float32, float64, string, []byte:
+		return v
+
+	case map[string]interface{}:
+		for key, val := range v {
+			v[key] = toStructValue(val)
+		}
+		return v
+
+	case []interface{}:
+		for i, val := range v {
+			v[i] = toStructValue(val)
+		}
+		return v
+
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uintptr:
+		return uint64(v)
+
+	case complex64, complex128:
+		return fmt.Sprint(v)
+
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+
+	case time.Duration:
+		// same as zapcore.SecondsDurationEncoder which is used by NewEncoderConfig
+		return v.Seconds()
+
+	case error:
+		return v.Error()
+
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	// reflected value
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+	var val interface{}
+	if err := json.Unmarshal(data, &val); err != nil {
+		return string(data)
+	}
+
+	return toStructValue(val)
+}
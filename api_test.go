@@ -0,0 +1,324 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/logging/apiv2/loggingpb"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	mrpb "google.golang.org/genproto/googleapis/api/monitoredres"
+	logtypepb "google.golang.org/genproto/googleapis/logging/type"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zchee/zapcl/pkg/monitoredresource"
+)
+
+// fakeLoggingServer is the in-process fake of the Cloud Logging LoggingServiceV2 server.
+type fakeLoggingServer struct {
+	loggingpb.UnimplementedLoggingServiceV2Server
+
+	mu   sync.Mutex
+	reqs []*loggingpb.WriteLogEntriesRequest
+	errs []error // returned in order by each call
+}
+
+func (s *fakeLoggingServer) WriteLogEntries(_ context.Context, req *loggingpb.WriteLogEntriesRequest) (*loggingpb.WriteLogEntriesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.errs) > 0 {
+		err := s.errs[0]
+		s.errs = s.errs[1:]
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.reqs = append(s.reqs, req)
+
+	return &loggingpb.WriteLogEntriesResponse{}, nil
+}
+
+func (s *fakeLoggingServer) requests() []*loggingpb.WriteLogEntriesRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*loggingpb.WriteLogEntriesRequest(nil), s.reqs...)
+}
+
+// newFakeLoggingClient starts the fakeLoggingServer and returns the client connected to it.
+func newFakeLoggingClient(t *testing.T) (*fakeLoggingServer, loggingpb.LoggingServiceV2Client) {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	fake := &fakeLoggingServer{}
+	loggingpb.RegisterLoggingServiceV2Server(srv, fake)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return fake, loggingpb.NewLoggingServiceV2Client(conn)
+}
+
+var testResource = &monitoredresource.MonitoredResource{
+	LogID: "run.googleapis.com%2Fstdout",
+	MonitoredResource: &mrpb.MonitoredResource{
+		Type: string(monitoredresource.CloudRunRevision),
+		Labels: map[string]string{
+			"project_id":   "test-project",
+			"service_name": "test-service",
+		},
+	},
+}
+
+func TestAPICore(t *testing.T) {
+	t.Parallel()
+
+	fake, client := newFakeLoggingClient(t)
+	core := NewAPICore(client, zapcore.DebugLevel, WithAPIResource(testResource))
+
+	now := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	logger := zap.New(core, zap.WithClock(fixedClock(now))).Named("test").With(
+		zap.String("context", "value"),
+		Labels("env", "test"),
+	)
+	logger.Warn("hello world",
+		zap.Int("count", 1),
+		zap.Duration("elapsed", 1500*time.Millisecond),
+		zap.Strings("tags", []string{"a", "b"}),
+		HTTP(&HTTPPayload{
+			HttpRequest: &logtypepb.HttpRequest{
+				RequestMethod: "GET",
+				Status:        200,
+				Latency:       durationpb.New(time.Second),
+			},
+		}),
+		OperationStart("op-id", "producer"),
+		zap.String(TraceKey, "projects/test-project/traces/0123456789abcdef0123456789abcdef"),
+		zap.String(SpanKey, "0123456789abcdef"),
+		zap.Bool(TraceSampledKey, true),
+	)
+	if err := logger.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := fake.requests()
+	if len(reqs) != 1 {
+		t.Fatalf("got %d requests but want 1", len(reqs))
+	}
+
+	payload, err := structpb.NewStruct(map[string]interface{}{
+		"message": "hello world",
+		"logger":  "test",
+		"context": "value",
+		"count":   1,
+		"elapsed": 1.5,
+		"tags":    []interface{}{"a", "b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &loggingpb.WriteLogEntriesRequest{
+		LogName:        "projects/test-project/logs/run.googleapis.com%2Fstdout",
+		Resource:       testResource.MonitoredResource,
+		PartialSuccess: true,
+		Entries: []*loggingpb.LogEntry{
+			{
+				Timestamp: timestamppb.New(now),
+				Severity:  logtypepb.LogSeverity_WARNING,
+				Labels: map[string]string{
+					"env": "test",
+				},
+				HttpRequest: &logtypepb.HttpRequest{
+					RequestMethod: "GET",
+					Status:        200,
+					Latency:       durationpb.New(time.Second),
+				},
+				Operation: &loggingpb.LogEntryOperation{
+					Id:       "op-id",
+					Producer: "producer",
+					First:    true,
+				},
+				Trace:        "projects/test-project/traces/0123456789abcdef0123456789abcdef",
+				SpanId:       "0123456789abcdef",
+				TraceSampled: true,
+				Payload: &loggingpb.LogEntry_JsonPayload{
+					JsonPayload: payload,
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, reqs[0], protocmp.Transform()); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+}
+
+func TestAPICoreLevel(t *testing.T) {
+	t.Parallel()
+
+	fake, client := newFakeLoggingClient(t)
+	logger := zap.New(NewAPICore(client, zapcore.InfoLevel, WithAPIResource(testResource), WithAPILogID("my-app")))
+
+	logger.Debug("debug")
+	logger.Info("info")
+	if err := logger.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := fake.requests()
+	if len(reqs) != 1 {
+		t.Fatalf("got %d requests but want 1", len(reqs))
+	}
+	if got, want := reqs[0].GetLogName(), "projects/test-project/logs/my-app"; got != want {
+		t.Fatalf("got %q but want %q", got, want)
+	}
+}
+
+func TestAPICoreWriteError(t *testing.T) {
+	t.Parallel()
+
+	fake, client := newFakeLoggingClient(t)
+	fake.errs = []error{status.Error(codes.PermissionDenied, "denied")}
+	core := NewAPICore(client, zapcore.DebugLevel, WithAPIResource(testResource))
+
+	if err := core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Message: "hello"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var dropped *DroppedEntriesError
+	if err := core.Sync(); !errors.As(err, &dropped) {
+		t.Fatalf("got %v but want %T", err, dropped)
+	}
+	if dropped.Dropped != 1 {
+		t.Fatalf("got %d dropped entries but want 1", dropped.Dropped)
+	}
+	if len(fake.requests()) != 0 {
+		t.Fatal("non-retryable error should not be retried")
+	}
+}
+
+func TestAPICoreNoProjectID(t *testing.T) {
+	t.Parallel()
+
+	fake, client := newFakeLoggingClient(t)
+	res := &monitoredresource.MonitoredResource{
+		LogID: "stdout",
+		MonitoredResource: &mrpb.MonitoredResource{
+			Type:   string(monitoredresource.Global),
+			Labels: map[string]string{"project_id": ""},
+		},
+	}
+
+	var hookErr error
+	core := NewAPICore(client, zapcore.DebugLevel, WithAPIResource(res), WithAPIErrorHook(func(err error) { hookErr = err }))
+	if !errors.Is(hookErr, ErrNoProjectID) {
+		t.Fatalf("got %v error by the hook but want %v", hookErr, ErrNoProjectID)
+	}
+
+	if err := core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Message: "hello"}, nil); !errors.Is(err, ErrNoProjectID) {
+		t.Fatalf("got %v but want %v", err, ErrNoProjectID)
+	}
+	if err := core.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.requests()) != 0 {
+		t.Fatal("log entries without the project ID should not be written")
+	}
+
+	// the project ID of the option makes the log name valid
+	core = NewAPICore(client, zapcore.DebugLevel, WithAPIResource(res), WithAPIProjectID("test-project"))
+	if err := core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Message: "hello"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := core.(*APICore).Close(); err != nil {
+		t.Fatal(err)
+	}
+	if reqs := fake.requests(); len(reqs) != 1 || reqs[0].GetLogName() != "projects/test-project/logs/stdout" {
+		t.Fatalf("got %v requests but want the one to projects/test-project/logs/stdout", reqs)
+	}
+}
+
+func TestAPICoreRetry(t *testing.T) {
+	t.Parallel()
+
+	fake, client := newFakeLoggingClient(t)
+	fake.errs = []error{
+		status.Error(codes.Unavailable, "unavailable"),
+		status.Error(codes.DeadlineExceeded, "deadline exceeded"),
+	}
+	core := NewAPICore(client, zapcore.DebugLevel,
+		WithAPIResource(testResource),
+		WithAPIRetry(time.Millisecond, 10*time.Millisecond, 3),
+	)
+
+	if err := core.Write(zapcore.Entry{Level: zapcore.InfoLevel, Message: "hello"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := core.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(fake.requests()); got != 1 {
+		t.Fatalf("got %d requests but want 1", got)
+	}
+}
+
+func TestAPICoreBundle(t *testing.T) {
+	t.Parallel()
+
+	fake, client := newFakeLoggingClient(t)
+	core := NewAPICore(client, zapcore.DebugLevel,
+		WithAPIResource(testResource),
+		WithAPIBundleCountThreshold(3),
+		WithAPIBundleDelayThreshold(time.Hour),
+	)
+	t.Cleanup(func() { core.(*APICore).Close() })
+
+	logger := zap.New(core)
+	for i := 0; i < 7; i++ {
+		logger.Info("hello", zap.Int("i", i))
+	}
+	if err := logger.Sync(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []int
+	for _, req := range fake.requests() {
+		got = append(got, len(req.GetEntries()))
+	}
+	if diff := cmp.Diff([]int{3, 3, 1}, got); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+}
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time { return time.Time(c) }
+
+func (c fixedClock) NewTicker(d time.Duration) *time.Ticker { return time.NewTicker(d) }
@@ -0,0 +1,354 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/logging/apiv2/loggingpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxRequestBytes is the size limit of the WriteLogEntries request.
+	//
+	// https://cloud.google.com/logging/quotas#api-limits
+	maxRequestBytes = 10 << 20
+
+	// defaultBundleByteThreshold leaves the headroom for the log name and resource under the maxRequestBytes.
+	defaultBundleByteThreshold = 9 << 20
+
+	defaultBundleCountThreshold = 1000
+	defaultBundleDelayThreshold = time.Second
+	defaultBufferedEntryLimit   = 10000
+
+	defaultRetryInitialBackoff = 100 * time.Millisecond
+	defaultRetryMaxBackoff     = 5 * time.Second
+	defaultRetryMaxAttempts    = 5
+)
+
+// OverflowPolicy is the behavior of APICore when the buffer of the pending log entries fills.
+type OverflowPolicy uint8
+
+const (
+	// OverflowBlock blocks the logging call until the buffer has space.
+	OverflowBlock OverflowPolicy = iota
+
+	// OverflowDropNewest drops the log entry being written.
+	OverflowDropNewest
+
+	// OverflowDropOldest drops the oldest pending log entry in the buffer.
+	OverflowDropOldest
+)
+
+// DroppedEntriesError is returned by APICore.Sync when log entries were dropped since the last Sync,
+// either by the OverflowPolicy or by the WriteLogEntries failure.
+type DroppedEntriesError struct {
+	// Dropped is the number of dropped log entries.
+	Dropped uint64
+
+	// Err is the last error of WriteLogEntries, if any.
+	Err error
+}
+
+// Error implements error.
+func (e *DroppedEntriesError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("dropped %d log entries: %v", e.Dropped, e.Err)
+	}
+
+	return fmt.Sprintf("dropped %d log entries", e.Dropped)
+}
+
+// Unwrap returns the underlying error.
+func (e *DroppedEntriesError) Unwrap() error {
+	return e.Err
+}
+
+// bundlerConfig is the configuration of the bundler.
+type bundlerConfig struct {
+	countThreshold int
+	byteThreshold  int
+	delayThreshold time.Duration
+	bufferLimit    int
+	overflow       OverflowPolicy
+
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxAttempts    int
+}
+
+func defaultBundlerConfig() bundlerConfig {
+	return bundlerConfig{
+		countThreshold: defaultBundleCountThreshold,
+		byteThreshold:  defaultBundleByteThreshold,
+		delayThreshold: defaultBundleDelayThreshold,
+		bufferLimit:    defaultBufferedEntryLimit,
+		overflow:       OverflowBlock,
+		initialBackoff: defaultRetryInitialBackoff,
+		maxBackoff:     defaultRetryMaxBackoff,
+		maxAttempts:    defaultRetryMaxAttempts,
+	}
+}
+
+// bundler groups the log entries into the WriteLogEntries requests by count, size and delay,
+// and sends them in the background with retrying the retryable errors.
+type bundler struct {
+	bundlerConfig
+
+	send func(entries []*loggingpb.LogEntry) error
+
+	mu      sync.Mutex
+	space   *sync.Cond // signaled when the buffer has space
+	entries []*loggingpb.LogEntry
+	sizes   []int
+	bytes   int
+	dropped uint64
+	err     error
+	closed  bool
+
+	kick    chan struct{}
+	flushes chan chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+func newBundler(cfg bundlerConfig, send func(entries []*loggingpb.LogEntry) error) *bundler {
+	if cfg.byteThreshold <= 0 || cfg.byteThreshold > maxRequestBytes {
+		cfg.byteThreshold = defaultBundleByteThreshold
+	}
+	if cfg.countThreshold <= 0 {
+		cfg.countThreshold = defaultBundleCountThreshold
+	}
+	if cfg.delayThreshold <= 0 {
+		cfg.delayThreshold = defaultBundleDelayThreshold
+	}
+	if cfg.bufferLimit <= 0 {
+		cfg.bufferLimit = defaultBufferedEntryLimit
+	}
+	if cfg.maxAttempts <= 0 {
+		cfg.maxAttempts = 1
+	}
+
+	b := &bundler{
+		bundlerConfig: cfg,
+		send:          send,
+		kick:          make(chan struct{}, 1),
+		flushes:       make(chan chan struct{}),
+		done:          make(chan struct{}),
+		stopped:       make(chan struct{}),
+	}
+	b.space = sync.NewCond(&b.mu)
+	go b.loop()
+
+	return b
+}
+
+// add adds entry to the buffer, applying the OverflowPolicy if the buffer is full.
+func (b *bundler) add(entry *loggingpb.LogEntry) {
+	size := proto.Size(entry)
+
+	b.mu.Lock()
+	for !b.closed && len(b.entries) >= b.bufferLimit {
+		switch b.overflow {
+		case OverflowDropNewest:
+			b.dropped++
+			b.mu.Unlock()
+			return
+
+		case OverflowDropOldest:
+			b.bytes -= b.sizes[0]
+			b.entries[0] = nil
+			b.entries, b.sizes = b.entries[1:], b.sizes[1:]
+			b.dropped++
+
+		default:
+			b.space.Wait()
+		}
+	}
+	if b.closed {
+		b.dropped++
+		b.mu.Unlock()
+		return
+	}
+
+	b.entries = append(b.entries, entry)
+	b.sizes = append(b.sizes, size)
+	b.bytes += size
+	full := len(b.entries) >= b.countThreshold || b.bytes >= b.byteThreshold
+	b.mu.Unlock()
+
+	if full {
+		select {
+		case b.kick <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// flush sends all the buffered log entries, and waits for it until ctx is done.
+func (b *bundler) flush(ctx context.Context) error {
+	done := make(chan struct{})
+	select {
+	case b.flushes <- done:
+	case <-b.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// close flushes the buffered log entries and stops the background goroutine.
+func (b *bundler) close(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		close(b.done)
+		b.space.Broadcast()
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-b.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// stats returns the number of dropped log entries and the last send error since the last call, and resets them.
+func (b *bundler) stats() (dropped uint64, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	dropped, err = b.dropped, b.err
+	b.dropped, b.err = 0, nil
+
+	return dropped, err
+}
+
+func (b *bundler) loop() {
+	defer close(b.stopped)
+
+	ticker := time.NewTicker(b.delayThreshold)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.kick:
+			b.sendBundles(false)
+
+		case <-ticker.C:
+			b.sendBundles(true)
+
+		case done := <-b.flushes:
+			b.sendBundles(true)
+			close(done)
+
+		case <-b.done:
+			b.sendBundles(true)
+			return
+		}
+	}
+}
+
+// sendBundles sends the buffered log entries. If all is false, it sends only the bundles which reached the thresholds.
+func (b *bundler) sendBundles(all bool) {
+	for {
+		entries := b.take(all)
+		if len(entries) == 0 {
+			return
+		}
+
+		if err := b.sendWithRetry(entries); err != nil {
+			b.mu.Lock()
+			b.dropped += uint64(len(entries))
+			b.err = err
+			b.mu.Unlock()
+		}
+	}
+}
+
+// take removes a bundle from the front of the buffer.
+func (b *bundler) take(all bool) []*loggingpb.LogEntry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n, bytes := 0, 0
+	for n < len(b.entries) && n < b.countThreshold {
+		// always take at least one entry even if it exceeds the byteThreshold alone
+		if n > 0 && bytes+b.sizes[n] > b.byteThreshold {
+			break
+		}
+		bytes += b.sizes[n]
+		n++
+	}
+	full := n == b.countThreshold || n < len(b.entries)
+	if n == 0 || (!all && !full) {
+		return nil
+	}
+
+	entries := make([]*loggingpb.LogEntry, n)
+	copy(entries, b.entries[:n])
+	for i := 0; i < n; i++ {
+		b.entries[i] = nil
+	}
+	b.entries, b.sizes = b.entries[n:], b.sizes[n:]
+	b.bytes -= bytes
+	b.space.Broadcast()
+
+	return entries
+}
+
+// sendWithRetry sends entries with retrying the retryable errors with the exponential backoff.
+func (b *bundler) sendWithRetry(entries []*loggingpb.LogEntry) error {
+	backoff := b.initialBackoff
+	for attempt := 1; ; attempt++ {
+		err := b.send(entries)
+		if err == nil || !isRetryable(err) || attempt >= b.maxAttempts {
+			return err
+		}
+
+		// full jitter
+		time.Sleep(time.Duration(rand.Int63n(int64(backoff) + 1))) //nolint:gosec // no need the crypto/rand for jitter
+
+		backoff *= 2
+		if backoff > b.maxBackoff {
+			backoff = b.maxBackoff
+		}
+	}
+}
+
+// isRetryable reports whether the err of WriteLogEntries is retryable.
+func isRetryable(err error) bool {
+	var se interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &se) {
+		return false
+	}
+
+	switch se.GRPCStatus().Code() {
+	case codes.DeadlineExceeded,
+		codes.Internal,
+		codes.Unavailable,
+		codes.ResourceExhausted:
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,157 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/logging/apiv2/loggingpb"
+	"github.com/google/go-cmp/cmp"
+)
+
+// recordSender records the messages of the sent log entries.
+type recordSender struct {
+	mu   sync.Mutex
+	msgs []string
+}
+
+func (s *recordSender) send(entries []*loggingpb.LogEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range entries {
+		s.msgs = append(s.msgs, e.GetTextPayload())
+	}
+
+	return nil
+}
+
+func (s *recordSender) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.msgs...)
+}
+
+func textEntry(msg string) *loggingpb.LogEntry {
+	return &loggingpb.LogEntry{
+		Payload: &loggingpb.LogEntry_TextPayload{TextPayload: msg},
+	}
+}
+
+func newTestBundler(t *testing.T, bufferLimit int, policy OverflowPolicy) (*bundler, *recordSender) {
+	t.Helper()
+
+	cfg := defaultBundlerConfig()
+	cfg.delayThreshold = time.Hour
+	cfg.bufferLimit = bufferLimit
+	cfg.overflow = policy
+	sender := new(recordSender)
+	b := newBundler(cfg, sender.send)
+	t.Cleanup(func() { b.close(context.Background()) })
+
+	return b, sender
+}
+
+func TestBundlerOverflow(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		policy      OverflowPolicy
+		want        []string
+		wantDropped uint64
+	}{
+		"DropNewest": {
+			policy:      OverflowDropNewest,
+			want:        []string{"1", "2"},
+			wantDropped: 3,
+		},
+		"DropOldest": {
+			policy:      OverflowDropOldest,
+			want:        []string{"4", "5"},
+			wantDropped: 3,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, sender := newTestBundler(t, 2, tt.policy)
+			for _, msg := range []string{"1", "2", "3", "4", "5"} {
+				b.add(textEntry(msg))
+			}
+			if err := b.flush(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tt.want, sender.messages()); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+			if dropped, _ := b.stats(); dropped != tt.wantDropped {
+				t.Fatalf("got %d dropped entries but want %d", dropped, tt.wantDropped)
+			}
+		})
+	}
+}
+
+func TestBundlerOverflowBlock(t *testing.T) {
+	t.Parallel()
+
+	b, sender := newTestBundler(t, 1, OverflowBlock)
+	b.add(textEntry("1"))
+
+	added := make(chan struct{})
+	go func() {
+		b.add(textEntry("2"))
+		close(added)
+	}()
+
+	select {
+	case <-added:
+		t.Fatal("add should block until the buffer has space")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	if err := b.flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	<-added
+	if err := b.flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"1", "2"}, sender.messages()); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+	if dropped, _ := b.stats(); dropped != 0 {
+		t.Fatalf("got %d dropped entries but want 0", dropped)
+	}
+}
+
+func TestBundlerFlushDeadline(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	cfg := defaultBundlerConfig()
+	b := newBundler(cfg, func([]*loggingpb.LogEntry) error {
+		<-release
+		return nil
+	})
+	t.Cleanup(func() {
+		close(release)
+		b.close(context.Background())
+	})
+
+	b.add(textEntry("1"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.flush(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("got %v but want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,452 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/buffer"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// InsertIDKey is the unique identifier of the log entry, which is used to de-duplicate the log entries with the
+	// same timestamp and insertId. For more information.
+	//
+	// insertId field:
+	// - https://cloud.google.com/logging/docs/structured-logging#special-payload-fields
+	// - https://cloud.google.com/logging/docs/reference/v2/rest/v2/LogEntry#FIELDS.insert_id
+	InsertIDKey = "logging.googleapis.com/insertId"
+)
+
+// InsertID adds the Cloud Logging "insertId" field.
+func InsertID(id string) zapcore.Field {
+	return zap.String(InsertIDKey, id)
+}
+
+// specialFieldKeys is the keys of the Cloud Logging special payload fields in the encoding order.
+var specialFieldKeys = [...]string{
+	InsertIDKey,
+	HTTPRequestKey,
+	LabelsKey,
+	OperationKey,
+	SourceLocationKey,
+	SpanKey,
+	TraceKey,
+	TraceSampledKey,
+}
+
+// specialFieldIndex returns the index of key in specialFieldKeys, or -1 if key is not the special field.
+func specialFieldIndex(key string) int {
+	for i := range specialFieldKeys {
+		if specialFieldKeys[i] == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// specialField validates the type of the special field f, and returns the field which is encoded as is.
+//
+// The string special fields accept the string, byte string and fmt.Stringer fields, and are normalized into the
+// string field. The other special fields accept the bool field or the object field.
+func specialField(f zapcore.Field) (zapcore.Field, error) {
+	switch f.Key {
+	case InsertIDKey, SpanKey, TraceKey:
+		switch f.Type {
+		case zapcore.StringType:
+			return f, nil
+		case zapcore.ByteStringType:
+			return zap.String(f.Key, string(f.Interface.([]byte))), nil
+		case zapcore.StringerType:
+			return zap.String(f.Key, f.Interface.(fmt.Stringer).String()), nil
+		}
+		return f, fmt.Errorf("zapcl: %s field must be string", f.Key)
+
+	case TraceSampledKey:
+		if f.Type != zapcore.BoolType {
+			return f, fmt.Errorf("zapcl: %s field must be bool", f.Key)
+		}
+
+	default:
+		if f.Type != zapcore.ObjectMarshalerType {
+			return f, fmt.Errorf("zapcl: %s field must be object", f.Key)
+		}
+	}
+
+	return f, nil
+}
+
+// topLevelField is the zapcore.ObjectMarshaler which adds the field inline.
+//
+// The encoder encodes it at the top level right after the special fields even if the namespace is open, and the other
+// encoders encode it inline as is.
+type topLevelField zapcore.Field
+
+// MarshalLogObject implements zapcore.ObjectMarshaler.
+func (f topLevelField) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	zapcore.Field(f).AddTo(enc)
+	return nil
+}
+
+// topLevel returns the field which adds f at the top level of the entry encoded by the encoder.
+func topLevel(f zapcore.Field) zapcore.Field {
+	return zap.Inline(topLevelField(f))
+}
+
+// isTopLevel reports whether f is the field returned by topLevel.
+func isTopLevel(f zapcore.Field) bool {
+	if f.Type != zapcore.InlineMarshalerType {
+		return false
+	}
+	_, ok := f.Interface.(topLevelField)
+
+	return ok
+}
+
+// encoder is the zapcore.Encoder which encodes the entry in the Cloud Logging structured logging format.
+type encoder struct {
+	// Encoder is the JSON encoder which encodes the context and the log site fields, and the stack trace
+	zapcore.Encoder
+
+	// head is the JSON encoder which encodes the entry keys and the special fields
+	head zapcore.Encoder
+
+	// special is the special fields added by zap.Logger.With, and labels is the merged labels of them
+	special [len(specialFieldKeys)]zapcore.Field
+	labels  *labelMap
+
+	// specials is the encoding fields of special and labels, which is rebuilt when they are changed
+	specials []zapcore.Field
+}
+
+var _ zapcore.Encoder = (*encoder)(nil)
+
+// bufferPool is the pool of the buffers which the encoder returns.
+var bufferPool = buffer.NewPool()
+
+// NewEncoder returns the zapcore.Encoder which encodes the entry in the Cloud Logging structured logging format.
+//
+// The Cloud Logging special payload fields, such as "logging.googleapis.com/trace" and "httpRequest", are always
+// encoded at the top level right after the entry keys of cfg, even if they are added after zap.Namespace. The special
+// field added twice by zap.Logger.With or the log site is encoded once, and the last one wins, except the labels
+// fields which are merged into the one "labels" field same as Labels.
+//
+// The special field of the invalid type, such as the int "logging.googleapis.com/trace_sampled" field, is not
+// encoded, and the "<key>Error" field reports it instead.
+func NewEncoder(cfg zapcore.EncoderConfig) zapcore.Encoder {
+	return newEncoder(cfg)
+}
+
+func newEncoder(cfg zapcore.EncoderConfig) *encoder {
+	headCfg := cfg
+	headCfg.StacktraceKey = ""
+	headCfg.SkipLineEnding = true
+
+	tailCfg := zapcore.EncoderConfig{
+		StacktraceKey:       cfg.StacktraceKey,
+		SkipLineEnding:      cfg.SkipLineEnding,
+		LineEnding:          cfg.LineEnding,
+		EncodeTime:          cfg.EncodeTime,
+		EncodeDuration:      cfg.EncodeDuration,
+		NewReflectedEncoder: cfg.NewReflectedEncoder,
+	}
+
+	return &encoder{
+		Encoder: zapcore.NewJSONEncoder(tailCfg),
+		head:    zapcore.NewJSONEncoder(headCfg),
+	}
+}
+
+// Clone implements zapcore.Encoder.
+func (e *encoder) Clone() zapcore.Encoder {
+	return &encoder{
+		Encoder:  e.Encoder.Clone(),
+		head:     e.head,
+		special:  e.special,
+		labels:   e.labels,
+		specials: e.specials,
+	}
+}
+
+// addSpecial holds the special field f until the entry is encoded, and reports whether f is the special field.
+func (e *encoder) addSpecial(f zapcore.Field) bool {
+	i := specialFieldIndex(f.Key)
+	if i < 0 {
+		return false
+	}
+
+	if labels, ok := labelsFromField(f); ok {
+		e.labels = e.labels.merge(labels)
+		e.specials = specialFields(&e.special, e.labels)
+		return true
+	}
+	f, err := specialField(f)
+	if err != nil {
+		e.Encoder.AddString(f.Key+"Error", err.Error())
+		return true
+	}
+	e.special[i] = f
+	e.specials = specialFields(&e.special, e.labels)
+
+	return true
+}
+
+// specialFields returns the fields of special and labels in the encoding order.
+func specialFields(special *[len(specialFieldKeys)]zapcore.Field, labels *labelMap) []zapcore.Field {
+	fields := make([]zapcore.Field, 0, len(special))
+	for i := range special {
+		switch {
+		case specialFieldKeys[i] == LabelsKey && labels != nil:
+			fields = append(fields, zap.Object(LabelsKey, labels))
+		case special[i].Type != zapcore.UnknownType:
+			fields = append(fields, special[i])
+		}
+	}
+
+	return fields
+}
+
+// EncodeEntry implements zapcore.Encoder.
+func (e *encoder) EncodeEntry(ent zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
+	specials, rest := e.specials, fields
+	for i := range fields {
+		if specialFieldIndex(fields[i].Key) >= 0 || isTopLevel(fields[i]) {
+			specials, rest = e.splitFields(fields)
+			break
+		}
+	}
+
+	head, err := e.head.EncodeEntry(ent, specials)
+	if err != nil {
+		return nil, err
+	}
+	defer head.Free()
+	tail, err := e.Encoder.EncodeEntry(zapcore.Entry{Stack: ent.Stack}, rest)
+	if err != nil {
+		return nil, err
+	}
+	defer tail.Free()
+
+	// join the head object "{...}" and the tail object "{...}\n" into the one object
+	buf := bufferPool.Get()
+	h, t := head.Bytes(), tail.Bytes()
+	buf.Write(h[:len(h)-1])
+	if len(h) > 2 && t[1] != '}' {
+		buf.AppendByte(',')
+	}
+	buf.Write(t[1:])
+
+	return buf, nil
+}
+
+// splitFields returns the special fields of the encoder and fields followed by the top level fields, and the rest of
+// fields.
+func (e *encoder) splitFields(fields []zapcore.Field) (specials, rest []zapcore.Field) {
+	special := e.special
+	labels := e.labels
+	var top []zapcore.Field
+	rest = make([]zapcore.Field, 0, len(fields))
+	for i := range fields {
+		if isTopLevel(fields[i]) {
+			top = append(top, zapcore.Field(fields[i].Interface.(topLevelField)))
+			continue
+		}
+		idx := specialFieldIndex(fields[i].Key)
+		if idx < 0 {
+			rest = append(rest, fields[i])
+			continue
+		}
+
+		if l, ok := labelsFromField(fields[i]); ok {
+			labels = labels.merge(l)
+			continue
+		}
+		f, err := specialField(fields[i])
+		if err != nil {
+			rest = append(rest, zap.String(f.Key+"Error", err.Error()))
+			continue
+		}
+		special[idx] = f
+	}
+
+	return append(specialFields(&special, labels), top...), rest
+}
+
+// AddArray implements zapcore.ObjectEncoder.
+func (e *encoder) AddArray(key string, arr zapcore.ArrayMarshaler) error {
+	if e.addSpecial(zap.Array(key, arr)) {
+		return nil
+	}
+
+	return e.Encoder.AddArray(key, arr)
+}
+
+// AddObject implements zapcore.ObjectEncoder.
+func (e *encoder) AddObject(key string, obj zapcore.ObjectMarshaler) error {
+	if e.addSpecial(zap.Object(key, obj)) {
+		return nil
+	}
+
+	return e.Encoder.AddObject(key, obj)
+}
+
+// AddBinary implements zapcore.ObjectEncoder.
+func (e *encoder) AddBinary(key string, val []byte) {
+	if !e.addSpecial(zap.Binary(key, val)) {
+		e.Encoder.AddBinary(key, val)
+	}
+}
+
+// AddByteString implements zapcore.ObjectEncoder.
+func (e *encoder) AddByteString(key string, val []byte) {
+	if !e.addSpecial(zap.ByteString(key, val)) {
+		e.Encoder.AddByteString(key, val)
+	}
+}
+
+// AddBool implements zapcore.ObjectEncoder.
+func (e *encoder) AddBool(key string, val bool) {
+	if !e.addSpecial(zap.Bool(key, val)) {
+		e.Encoder.AddBool(key, val)
+	}
+}
+
+// AddComplex128 implements zapcore.ObjectEncoder.
+func (e *encoder) AddComplex128(key string, val complex128) {
+	if !e.addSpecial(zap.Complex128(key, val)) {
+		e.Encoder.AddComplex128(key, val)
+	}
+}
+
+// AddComplex64 implements zapcore.ObjectEncoder.
+func (e *encoder) AddComplex64(key string, val complex64) {
+	if !e.addSpecial(zap.Complex64(key, val)) {
+		e.Encoder.AddComplex64(key, val)
+	}
+}
+
+// AddDuration implements zapcore.ObjectEncoder.
+func (e *encoder) AddDuration(key string, val time.Duration) {
+	if !e.addSpecial(zap.Duration(key, val)) {
+		e.Encoder.AddDuration(key, val)
+	}
+}
+
+// AddFloat64 implements zapcore.ObjectEncoder.
+func (e *encoder) AddFloat64(key string, val float64) {
+	if !e.addSpecial(zap.Float64(key, val)) {
+		e.Encoder.AddFloat64(key, val)
+	}
+}
+
+// AddFloat32 implements zapcore.ObjectEncoder.
+func (e *encoder) AddFloat32(key string, val float32) {
+	if !e.addSpecial(zap.Float32(key, val)) {
+		e.Encoder.AddFloat32(key, val)
+	}
+}
+
+// AddInt implements zapcore.ObjectEncoder.
+func (e *encoder) AddInt(key string, val int) {
+	if !e.addSpecial(zap.Int(key, val)) {
+		e.Encoder.AddInt(key, val)
+	}
+}
+
+// AddInt64 implements zapcore.ObjectEncoder.
+func (e *encoder) AddInt64(key string, val int64) {
+	if !e.addSpecial(zap.Int64(key, val)) {
+		e.Encoder.AddInt64(key, val)
+	}
+}
+
+// AddInt32 implements zapcore.ObjectEncoder.
+func (e *encoder) AddInt32(key string, val int32) {
+	if !e.addSpecial(zap.Int32(key, val)) {
+		e.Encoder.AddInt32(key, val)
+	}
+}
+
+// AddInt16 implements zapcore.ObjectEncoder.
+func (e *encoder) AddInt16(key string, val int16) {
+	if !e.addSpecial(zap.Int16(key, val)) {
+		e.Encoder.AddInt16(key, val)
+	}
+}
+
+// AddInt8 implements zapcore.ObjectEncoder.
+func (e *encoder) AddInt8(key string, val int8) {
+	if !e.addSpecial(zap.Int8(key, val)) {
+		e.Encoder.AddInt8(key, val)
+	}
+}
+
+// AddString implements zapcore.ObjectEncoder.
+func (e *encoder) AddString(key, val string) {
+	if !e.addSpecial(zap.String(key, val)) {
+		e.Encoder.AddString(key, val)
+	}
+}
+
+// AddTime implements zapcore.ObjectEncoder.
+func (e *encoder) AddTime(key string, val time.Time) {
+	if !e.addSpecial(zap.Time(key, val)) {
+		e.Encoder.AddTime(key, val)
+	}
+}
+
+// AddUint implements zapcore.ObjectEncoder.
+func (e *encoder) AddUint(key string, val uint) {
+	if !e.addSpecial(zap.Uint(key, val)) {
+		e.Encoder.AddUint(key, val)
+	}
+}
+
+// AddUint64 implements zapcore.ObjectEncoder.
+func (e *encoder) AddUint64(key string, val uint64) {
+	if !e.addSpecial(zap.Uint64(key, val)) {
+		e.Encoder.AddUint64(key, val)
+	}
+}
+
+// AddUint32 implements zapcore.ObjectEncoder.
+func (e *encoder) AddUint32(key string, val uint32) {
+	if !e.addSpecial(zap.Uint32(key, val)) {
+		e.Encoder.AddUint32(key, val)
+	}
+}
+
+// AddUint16 implements zapcore.ObjectEncoder.
+func (e *encoder) AddUint16(key string, val uint16) {
+	if !e.addSpecial(zap.Uint16(key, val)) {
+		e.Encoder.AddUint16(key, val)
+	}
+}
+
+// AddUint8 implements zapcore.ObjectEncoder.
+func (e *encoder) AddUint8(key string, val uint8) {
+	if !e.addSpecial(zap.Uint8(key, val)) {
+		e.Encoder.AddUint8(key, val)
+	}
+}
+
+// AddUintptr implements zapcore.ObjectEncoder.
+func (e *encoder) AddUintptr(key string, val uintptr) {
+	if !e.addSpecial(zap.Uintptr(key, val)) {
+		e.Encoder.AddUintptr(key, val)
+	}
+}
+
+// AddReflected implements zapcore.ObjectEncoder.
+func (e *encoder) AddReflected(key string, val interface{}) error {
+	if e.addSpecial(zap.Reflect(key, val)) {
+		return nil
+	}
+
+	return e.Encoder.AddReflected(key, val)
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestEncoder(t *testing.T) {
+	t.Parallel()
+
+	ent := zapcore.Entry{
+		Level:   zapcore.InfoLevel,
+		Time:    time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		Message: "hello",
+	}
+	const head = `{"severity":"INFO","time":"2023-01-02T03:04:05Z","message":"hello"`
+
+	tests := map[string]struct {
+		with   []zapcore.Field
+		fields []zapcore.Field
+		stack  string
+		want   string
+	}{
+		"NoFields": {
+			want: head + "}\n",
+		},
+		"Fields": {
+			fields: []zapcore.Field{zap.String("key", "value")},
+			want:   head + `,"key":"value"}` + "\n",
+		},
+		"SpecialFieldsOrder": {
+			fields: []zapcore.Field{
+				zap.String("key", "value"),
+				zap.Bool(TraceSampledKey, true),
+				zap.String(TraceKey, "projects/test/traces/trace"),
+				InsertID("id"),
+				Label("env", "test"),
+			},
+			want: head + `,"logging.googleapis.com/insertId":"id","logging.googleapis.com/labels":{"env":"test"},` +
+				`"logging.googleapis.com/trace":"projects/test/traces/trace","logging.googleapis.com/trace_sampled":true,` +
+				`"key":"value"}` + "\n",
+		},
+		"LastWriterWins": {
+			with: []zapcore.Field{
+				zap.String(SpanKey, "with"),
+				Label("env", "with"),
+				Label("team", "core"),
+			},
+			fields: []zapcore.Field{
+				zap.String(SpanKey, "first"),
+				zap.String(SpanKey, "last"),
+				Label("env", "test"),
+			},
+			want: head + `,"logging.googleapis.com/labels":{"env":"test","team":"core"},` +
+				`"logging.googleapis.com/spanId":"last"}` + "\n",
+		},
+		"WithSpecialField": {
+			with: []zapcore.Field{zap.String(SpanKey, "with"), zap.String("key", "value")},
+			want: head + `,"logging.googleapis.com/spanId":"with","key":"value"}` + "\n",
+		},
+		"Namespace": {
+			with: []zapcore.Field{
+				zap.Namespace("with"),
+				zap.String("key", "value"),
+				zap.String(TraceKey, "projects/test/traces/trace"),
+			},
+			fields: []zapcore.Field{
+				zap.Namespace("call"),
+				zap.Stringer(SpanKey, zap.NewAtomicLevelAt(zapcore.DebugLevel)),
+			},
+			want: head + `,"logging.googleapis.com/spanId":"debug","logging.googleapis.com/trace":"projects/test/traces/trace",` +
+				`"with":{"key":"value","call":{}}}` + "\n",
+		},
+		"InvalidType": {
+			with: []zapcore.Field{zap.Int(TraceSampledKey, 1)},
+			fields: []zapcore.Field{
+				zap.Int(TraceKey, 1),
+				zap.String(HTTPRequestKey, "GET"),
+			},
+			want: head + `,"logging.googleapis.com/trace_sampledError":"zapcl: logging.googleapis.com/trace_sampled field must be bool",` +
+				`"logging.googleapis.com/traceError":"zapcl: logging.googleapis.com/trace field must be string",` +
+				`"httpRequestError":"zapcl: httpRequest field must be object"}` + "\n",
+		},
+		"Stacktrace": {
+			fields: []zapcore.Field{zap.String(SpanKey, "span"), zap.String("key", "value")},
+			stack:  "stack",
+			want:   head + `,"logging.googleapis.com/spanId":"span","key":"value","stacktrace":"stack"}` + "\n",
+		},
+		"OnlyStacktrace": {
+			stack: "stack",
+			want:  head + `,"stacktrace":"stack"}` + "\n",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			core := zapcore.NewCore(NewEncoder(NewEncoderConfig()), zapcore.AddSync(&buf), zapcore.DebugLevel).With(tt.with)
+
+			ent := ent
+			ent.Stack = tt.stack
+			if err := core.Write(ent, tt.fields); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tt.want, buf.String()); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestEncoderEmptyConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fields []zapcore.Field
+		want   string
+	}{
+		"NoFields": {
+			want: "{}\n",
+		},
+		"Special": {
+			fields: []zapcore.Field{zap.String(SpanKey, "span")},
+			want:   `{"logging.googleapis.com/spanId":"span"}` + "\n",
+		},
+		"Fields": {
+			fields: []zapcore.Field{zap.String("key", "value")},
+			want:   `{"key":"value"}` + "\n",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			buf, err := NewEncoder(zapcore.EncoderConfig{}).EncodeEntry(zapcore.Entry{}, tt.fields)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer buf.Free()
+
+			if diff := cmp.Diff(tt.want, buf.String()); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"cloud.google.com/go/logging/apiv2/loggingpb"
 	"go.uber.org/zap"
+	"go.uber.org/zap/buffer"
 	"go.uber.org/zap/zapcore"
 )
 
@@ -70,3 +71,168 @@ func newReportContext(pc uintptr, file string, line int, ok bool) *reportContext
 func ErrorReport(pc uintptr, file string, line int, ok bool) zap.Field {
 	return zap.Object(contextKey, newReportContext(pc, file, line, ok))
 }
+
+const (
+	// StackTraceKey is the key of the stack trace which Error Reporting parses.
+	//
+	// https://cloud.google.com/error-reporting/docs/formatting-error-messages#log-error
+	StackTraceKey = "stack_trace"
+
+	// ErrorReportTypeKey is the key of the type of the log entry payload.
+	ErrorReportTypeKey = "@type"
+
+	// ReportedErrorEventType is the value of ErrorReportTypeKey which makes Error Reporting to report the log entry
+	// whether or not it has the stack trace.
+	//
+	// https://cloud.google.com/error-reporting/docs/formatting-error-messages#reported-error-example
+	ReportedErrorEventType = "type.googleapis.com/google.devtools.clouderrorreporting.v1beta1.ReportedErrorEvent"
+)
+
+// errorReportEncoder is the zapcore.Encoder which encodes the entries at and above the level in the Error Reporting
+// format.
+type errorReportEncoder struct {
+	zapcore.Encoder
+
+	level zapcore.LevelEnabler
+
+	// hasType, hasStack and hasContext report whether the fields are already added by zap.Logger.With
+	hasType    bool
+	hasStack   bool
+	hasContext bool
+}
+
+// NewErrorReportEncoder returns the zapcore.Encoder which rewrites the entries enabled by level in the Error Reporting
+// format, and encodes them with enc.
+//
+// The rewritten entry has:
+//   - the StackTraceKey field which is the message followed by the entry stack trace in the Go panic format,
+//     instead of the zap format stack trace under the StacktraceKey
+//   - the ErrorReportTypeKey field of ReportedErrorEventType
+//   - the "context.reportLocation" field from the entry caller, unless the ErrorReport field is given
+//
+// The added fields are encoded at the top level even after zap.Namespace if enc is the encoder of NewEncoder.
+// The fields already given at the log site or by zap.Logger.With, such as the stack trace reported by
+// RecoverAndReport, are kept as is.
+// The other entries are encoded by enc as is.
+func NewErrorReportEncoder(enc zapcore.Encoder, level zapcore.LevelEnabler) zapcore.Encoder {
+	return &errorReportEncoder{
+		Encoder: enc,
+		level:   level,
+	}
+}
+
+// WithErrorReport configures the Core to encode the entries at and above ErrorLevel in the Error Reporting format.
+//
+// See NewErrorReportEncoder.
+func WithErrorReport() Option {
+	return optionFunc(func(c *Core) {
+		c.errorReport = true
+	})
+}
+
+// Clone implements zapcore.Encoder.
+func (e *errorReportEncoder) Clone() zapcore.Encoder {
+	return &errorReportEncoder{
+		Encoder:    e.Encoder.Clone(),
+		level:      e.level,
+		hasType:    e.hasType,
+		hasStack:   e.hasStack,
+		hasContext: e.hasContext,
+	}
+}
+
+// addKey records key of the field added by zap.Logger.With.
+func (e *errorReportEncoder) addKey(key string) {
+	switch key {
+	case ErrorReportTypeKey:
+		e.hasType = true
+	case StackTraceKey:
+		e.hasStack = true
+	case contextKey:
+		e.hasContext = true
+	}
+}
+
+// AddObject implements zapcore.ObjectEncoder.
+func (e *errorReportEncoder) AddObject(key string, obj zapcore.ObjectMarshaler) error {
+	e.addKey(key)
+	return e.Encoder.AddObject(key, obj)
+}
+
+// AddReflected implements zapcore.ObjectEncoder.
+func (e *errorReportEncoder) AddReflected(key string, val interface{}) error {
+	e.addKey(key)
+	return e.Encoder.AddReflected(key, val)
+}
+
+// AddString implements zapcore.ObjectEncoder.
+func (e *errorReportEncoder) AddString(key, val string) {
+	e.addKey(key)
+	e.Encoder.AddString(key, val)
+}
+
+// EncodeEntry implements zapcore.Encoder.
+func (e *errorReportEncoder) EncodeEntry(ent zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
+	if !e.level.Enabled(ent.Level) {
+		return e.Encoder.EncodeEntry(ent, fields)
+	}
+
+	hasType, hasStack, hasContext := e.hasType, e.hasStack, e.hasContext
+	for i := range fields {
+		switch fields[i].Key {
+		case ErrorReportTypeKey:
+			hasType = true
+		case StackTraceKey:
+			hasStack = true
+		case contextKey:
+			hasContext = true
+		}
+	}
+
+	extra := make([]zapcore.Field, 0, len(fields)+3)
+	extra = append(extra, fields...)
+	if !hasType {
+		extra = append(extra, topLevel(zap.String(ErrorReportTypeKey, ReportedErrorEventType)))
+	}
+	if ent.Stack != "" && !hasStack {
+		extra = append(extra, topLevel(zap.String(StackTraceKey, ent.Message+"\n\n"+goroutineStack(ent.Stack))))
+	}
+	// the zap format stack trace is replaced by the StackTraceKey field
+	ent.Stack = ""
+	if !hasContext && ent.Caller.Defined {
+		extra = append(extra, topLevel(zap.Object(contextKey, &reportContext{
+			ReportLocation: &reportLocation{
+				LogEntrySourceLocation: &loggingpb.LogEntrySourceLocation{
+					File:     ent.Caller.File,
+					Line:     int64(ent.Caller.Line),
+					Function: ent.Caller.Function,
+				},
+			},
+		})))
+	}
+
+	return e.Encoder.EncodeEntry(ent, extra)
+}
+
+// goroutineStack converts the zap format stack trace to the Go panic format which Error Reporting parses.
+//
+// The zap format is the "function\n\tfile:line" frames separated by the newline. The converted stack trace is
+// started with the "goroutine 1 [running]:" header, and each function has the "(...)" arguments placeholder same as
+// the inlined function of the Go panic format.
+func goroutineStack(stack string) string {
+	var b strings.Builder
+	b.Grow(len(stack) + 32)
+	b.WriteString("goroutine 1 [running]:")
+
+	for _, line := range strings.Split(stack, "\n") {
+		b.WriteByte('\n')
+		if strings.HasPrefix(line, "\t") {
+			b.WriteString(line)
+			continue
+		}
+		b.WriteString(line)
+		b.WriteString("(...)")
+	}
+
+	return b.String()
+}
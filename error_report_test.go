@@ -6,6 +6,10 @@ package zapcl
 import (
 	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 func TestErrorReport(t *testing.T) {
@@ -39,3 +43,131 @@ func TestNewReportContext(t *testing.T) {
 		t.Errorf("except contains got %s in %s", gotFunc, wantFunc)
 	}
 }
+
+func TestErrorReportEncoder(t *testing.T) {
+	t.Parallel()
+
+	caller := zapcore.EntryCaller{
+		Defined:  true,
+		File:     "/go/src/example.com/app/main.go",
+		Line:     10,
+		Function: "main.main",
+	}
+	stack := "main.run\n\t/go/src/example.com/app/run.go:5\nmain.main\n\t/go/src/example.com/app/main.go:10"
+
+	tests := map[string]struct {
+		ent    zapcore.Entry
+		with   []zapcore.Field
+		fields []zapcore.Field
+		want   string
+	}{
+		"Error": {
+			ent: zapcore.Entry{
+				Level:   zapcore.ErrorLevel,
+				Message: "boom",
+				Caller:  caller,
+				Stack:   stack,
+			},
+			want: `{"severity":"ERROR","caller":"app/main.go:10","message":"boom",` +
+				`"@type":"type.googleapis.com/google.devtools.clouderrorreporting.v1beta1.ReportedErrorEvent",` +
+				`"stack_trace":"boom\n\ngoroutine 1 [running]:\nmain.run(...)\n\t/go/src/example.com/app/run.go:5\nmain.main(...)\n\t/go/src/example.com/app/main.go:10",` +
+				`"context":{"reportLocation":{"filePath":"/go/src/example.com/app/main.go","lineNumber":10,"functionName":"main.main"}}}` + "\n",
+		},
+		"ErrorWithoutStack": {
+			ent: zapcore.Entry{
+				Level:   zapcore.ErrorLevel,
+				Message: "boom",
+				Caller:  caller,
+			},
+			fields: []zapcore.Field{
+				ErrorReport(0, "/go/src/example.com/app/handler.go", 20, true),
+			},
+			want: `{"severity":"ERROR","caller":"app/main.go:10","message":"boom",` +
+				`"context":{"reportLocation":{"filePath":"/go/src/example.com/app/handler.go","lineNumber":20,"functionName":""}},` +
+				`"@type":"type.googleapis.com/google.devtools.clouderrorreporting.v1beta1.ReportedErrorEvent"}` + "\n",
+		},
+		"ErrorWith": {
+			ent: zapcore.Entry{
+				Level:   zapcore.ErrorLevel,
+				Message: "boom",
+				Caller:  caller,
+				Stack:   stack,
+			},
+			with: []zapcore.Field{
+				ErrorReport(0, "/go/src/example.com/app/handler.go", 20, true),
+				zap.String(ErrorReportTypeKey, ReportedErrorEventType),
+			},
+			want: `{"severity":"ERROR","caller":"app/main.go:10","message":"boom",` +
+				`"context":{"reportLocation":{"filePath":"/go/src/example.com/app/handler.go","lineNumber":20,"functionName":""}},` +
+				`"@type":"type.googleapis.com/google.devtools.clouderrorreporting.v1beta1.ReportedErrorEvent",` +
+				`"stack_trace":"boom\n\ngoroutine 1 [running]:\nmain.run(...)\n\t/go/src/example.com/app/run.go:5\nmain.main(...)\n\t/go/src/example.com/app/main.go:10"}` + "\n",
+		},
+		"Info": {
+			ent: zapcore.Entry{
+				Level:   zapcore.InfoLevel,
+				Message: "hello",
+				Caller:  caller,
+				Stack:   stack,
+			},
+			want: `{"severity":"INFO","caller":"app/main.go:10","message":"hello",` +
+				`"stacktrace":"main.run\n\t/go/src/example.com/app/run.go:5\nmain.main\n\t/go/src/example.com/app/main.go:10"}` + "\n",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := NewEncoderConfig()
+			cfg.TimeKey = ""
+			enc := NewErrorReportEncoder(zapcore.NewJSONEncoder(cfg), zapcore.ErrorLevel).Clone()
+			addFields(enc, tt.with)
+
+			buf, err := enc.EncodeEntry(tt.ent, tt.fields)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer buf.Free()
+
+			if diff := cmp.Diff(tt.want, buf.String()); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestErrorReportEncoderNamespace(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewEncoderConfig()
+	cfg.TimeKey = ""
+	enc := NewErrorReportEncoder(NewEncoder(cfg), zapcore.ErrorLevel).Clone()
+	addFields(enc, []zapcore.Field{zap.Namespace("req"), zap.String("method", "GET")})
+
+	ent := zapcore.Entry{
+		Level:   zapcore.ErrorLevel,
+		Message: "boom",
+		Caller: zapcore.EntryCaller{
+			Defined:  true,
+			File:     "/go/src/example.com/app/main.go",
+			Line:     10,
+			Function: "main.main",
+		},
+		Stack: "main.main\n\t/go/src/example.com/app/main.go:10",
+	}
+	buf, err := enc.EncodeEntry(ent, []zapcore.Field{zap.String("path", "/")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer buf.Free()
+
+	// the error report fields should not be encoded in the "req" namespace
+	want := `{"severity":"ERROR","caller":"app/main.go:10","message":"boom",` +
+		`"@type":"type.googleapis.com/google.devtools.clouderrorreporting.v1beta1.ReportedErrorEvent",` +
+		`"stack_trace":"boom\n\ngoroutine 1 [running]:\nmain.main(...)\n\t/go/src/example.com/app/main.go:10",` +
+		`"context":{"reportLocation":{"filePath":"/go/src/example.com/app/main.go","lineNumber":10,"functionName":"main.main"}},` +
+		`"req":{"method":"GET","path":"/"}}` + "\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+}
@@ -72,11 +72,7 @@ func NewHTTPRequest(r *http.Request, res *http.Response) *HTTPPayload {
 		},
 	}
 
-	if url := r.URL; url != nil {
-		u := *r.URL
-		u.Fragment = ""
-		req.RequestUrl = fixUTF8(u.String())
-	}
+	req.RequestUrl = requestURL(r)
 
 	buf := new(bytes.Buffer)
 	if body := r.Body; body != nil {
@@ -93,6 +89,18 @@ func NewHTTPRequest(r *http.Request, res *http.Response) *HTTPPayload {
 	return req
 }
 
+// requestURL returns the URL of r without the fragment.
+func requestURL(r *http.Request) string {
+	if r.URL == nil {
+		return ""
+	}
+
+	u := *r.URL
+	u.Fragment, u.RawFragment = "", ""
+
+	return fixUTF8(u.String())
+}
+
 // fixUTF8 is a helper that fixes an invalid UTF-8 string by replacing
 // invalid UTF-8 runes with the Unicode replacement character (U+FFFD).
 // See Issue https://github.com/googleapis/google-cloud-go/issues/1383.
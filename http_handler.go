@@ -0,0 +1,279 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	logtypepb "google.golang.org/genproto/googleapis/logging/type"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zchee/zapcl/pkg/traceheader"
+)
+
+// httpHandler is the http.Handler middleware which logs the one "httpRequest" entry per request.
+type httpHandler struct {
+	next    http.Handler
+	logger  *zap.Logger
+	levelFn func(status int) zapcore.Level
+	message func(r *http.Request) string
+	now     func() time.Time
+}
+
+// HTTPHandlerOption configures a NewHTTPHandler.
+type HTTPHandlerOption interface {
+	applyHTTPHandler(*httpHandler)
+}
+
+// httpHandlerOptionFunc wraps a func so it satisfies the HTTPHandlerOption interface.
+type httpHandlerOptionFunc func(*httpHandler)
+
+func (f httpHandlerOptionFunc) applyHTTPHandler(h *httpHandler) {
+	f(h)
+}
+
+// WithHTTPLevel configures the func which picks the entry level from the response status code.
+//
+// The default is HTTPStatusLevel.
+func WithHTTPLevel(fn func(status int) zapcore.Level) HTTPHandlerOption {
+	return httpHandlerOptionFunc(func(h *httpHandler) {
+		h.levelFn = fn
+	})
+}
+
+// WithHTTPMessage configures the func which returns the entry message of the request.
+//
+// The default message is the request method and path, such as "GET /index.html".
+func WithHTTPMessage(fn func(r *http.Request) string) HTTPHandlerOption {
+	return httpHandlerOptionFunc(func(h *httpHandler) {
+		h.message = fn
+	})
+}
+
+// HTTPStatusLevel returns the entry level of the HTTP status code class.
+//
+// The server errors (5xx) are logged at ErrorLevel, the client errors (4xx) are logged at WarnLevel,
+// and others are logged at InfoLevel.
+func HTTPStatusLevel(status int) zapcore.Level {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return zapcore.ErrorLevel
+	case status >= http.StatusBadRequest:
+		return zapcore.WarnLevel
+	}
+
+	return zapcore.InfoLevel
+}
+
+// NewHTTPHandler returns the http.Handler which logs the one entry with the "httpRequest" and trace fields per request
+// served by next.
+//
+// The trace context of the request headers is parsed by traceheader.FromRequest and stored in the request context,
+// so that next can correlate its own logs by WithContext.
+func NewHTTPHandler(logger *zap.Logger, next http.Handler, opts ...HTTPHandlerOption) http.Handler {
+	h := &httpHandler{
+		next:    next,
+		logger:  logger,
+		levelFn: HTTPStatusLevel,
+		message: func(r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		},
+		now: time.Now,
+	}
+	for _, opt := range opts {
+		opt.applyHTTPHandler(h)
+	}
+
+	return h
+}
+
+// HTTPMiddleware returns the middleware func of NewHTTPHandler.
+func HTTPMiddleware(logger *zap.Logger, opts ...HTTPHandlerOption) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return NewHTTPHandler(logger, next, opts...)
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *httpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := h.now()
+
+	if t, err := traceheader.FromRequest(r); err == nil {
+		r = r.WithContext(ContextWithTrace(r.Context(), t.TraceID, t.SpanID, t.Sampled))
+	}
+
+	var body *countingReadCloser
+	if r.Body != nil && r.Body != http.NoBody {
+		body = &countingReadCloser{ReadCloser: r.Body}
+		r.Body = body
+	}
+
+	rw := &responseWriter{ResponseWriter: w}
+	h.next.ServeHTTP(rw, r)
+
+	payload := &HTTPPayload{
+		HttpRequest: &logtypepb.HttpRequest{
+			RequestMethod: r.Method,
+			RequestUrl:    requestURL(r),
+			Status:        int32(rw.statusCode()),
+			ResponseSize:  rw.size,
+			UserAgent:     r.UserAgent(),
+			RemoteIp:      r.RemoteAddr,
+			Referer:       r.Referer(),
+			Latency:       durationpb.New(h.now().Sub(start)),
+			Protocol:      r.Proto,
+		},
+	}
+	if body != nil {
+		payload.RequestSize = body.n.Load()
+	}
+	// the handler may not read the whole body
+	if r.ContentLength > payload.RequestSize {
+		payload.RequestSize = r.ContentLength
+	}
+	if addr, ok := r.Context().Value(http.LocalAddrContextKey).(net.Addr); ok {
+		payload.ServerIp = addr.String()
+	}
+
+	ce := h.logger.Check(h.levelFn(rw.statusCode()), h.message(r))
+	if ce == nil {
+		return
+	}
+	fields := make([]zapcore.Field, 0, 4)
+	fields = append(fields, HTTP(payload))
+	fields = append(fields, TraceFromContext(r.Context())...)
+	ce.Write(fields...)
+}
+
+// countingReadCloser counts the bytes read from the io.ReadCloser without buffering.
+//
+// The count is atomic since http.Transport may read the request body in another goroutine.
+type countingReadCloser struct {
+	io.ReadCloser
+	n atomic.Int64
+}
+
+// Read implements io.Reader.
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.n.Add(int64(n))
+
+	return n, err
+}
+
+// responseWriter is the http.ResponseWriter which captures the status code and the response size.
+//
+// It passes http.Flusher, http.Hijacker and http.Pusher through to the underlying http.ResponseWriter,
+// and supports http.ResponseController by Unwrap.
+type responseWriter struct {
+	http.ResponseWriter
+
+	status   int
+	size     int64
+	hijacked bool
+}
+
+var (
+	_ http.Flusher  = (*responseWriter)(nil)
+	_ http.Hijacker = (*responseWriter)(nil)
+	_ http.Pusher   = (*responseWriter)(nil)
+)
+
+// statusCode returns the captured status code.
+func (w *responseWriter) statusCode() int {
+	switch {
+	case w.status != 0:
+		return w.status
+	case w.hijacked:
+		return http.StatusSwitchingProtocols
+	}
+
+	// net/http responds 200 if the handler writes nothing
+	return http.StatusOK
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *responseWriter) WriteHeader(statusCode int) {
+	// the informational headers may be written before the final header
+	if w.status == 0 && (statusCode >= http.StatusOK || statusCode == http.StatusSwitchingProtocols) {
+		w.status = statusCode
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write implements http.ResponseWriter.
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.size += int64(n)
+
+	return n, err
+}
+
+// ReadFrom implements io.ReaderFrom to keep the sendfile optimization of net/http.
+func (w *responseWriter) ReadFrom(r io.Reader) (int64, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	var n int64
+	var err error
+	if rf, ok := w.ResponseWriter.(io.ReaderFrom); ok {
+		n, err = rf.ReadFrom(r)
+	} else {
+		n, err = io.Copy(w.ResponseWriter, r)
+	}
+	w.size += n
+
+	return n, err
+}
+
+// Flush implements http.Flusher.
+//
+// Flush does nothing if the underlying http.ResponseWriter does not implement http.Flusher.
+func (w *responseWriter) Flush() {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker.
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, http.ErrNotSupported
+	}
+
+	conn, rw, err := h.Hijack()
+	if err == nil {
+		w.hijacked = true
+	}
+
+	return conn, rw, err
+}
+
+// Push implements http.Pusher.
+func (w *responseWriter) Push(target string, opts *http.PushOptions) error {
+	if p, ok := w.ResponseWriter.(http.Pusher); ok {
+		return p.Push(target, opts)
+	}
+
+	return http.ErrNotSupported
+}
+
+// Unwrap returns the underlying http.ResponseWriter for http.ResponseController.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,223 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	logtypepb "google.golang.org/genproto/googleapis/logging/type"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		handler   http.HandlerFunc
+		body      string
+		header    http.Header
+		wantLevel zapcore.Level
+		want      *logtypepb.HttpRequest
+		wantTrace bool
+	}{
+		"OK": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				io.Copy(io.Discard, r.Body) //nolint:errcheck
+				io.WriteString(w, "hello")  //nolint:errcheck
+			},
+			body:      "request body",
+			wantLevel: zapcore.InfoLevel,
+			want: &logtypepb.HttpRequest{
+				RequestMethod: "POST",
+				RequestUrl:    "http://example.com/path?q=1",
+				RequestSize:   12,
+				Status:        http.StatusOK,
+				ResponseSize:  5,
+				UserAgent:     "test-agent",
+				RemoteIp:      "192.0.2.1:1234",
+				Latency:       durationpb.New(500 * time.Millisecond),
+				Protocol:      "HTTP/1.1",
+			},
+		},
+		"UnreadBody": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+			body:      "request body",
+			wantLevel: zapcore.InfoLevel,
+			want: &logtypepb.HttpRequest{
+				RequestMethod: "POST",
+				RequestUrl:    "http://example.com/path?q=1",
+				RequestSize:   12,
+				Status:        http.StatusNoContent,
+				UserAgent:     "test-agent",
+				RemoteIp:      "192.0.2.1:1234",
+				Latency:       durationpb.New(500 * time.Millisecond),
+				Protocol:      "HTTP/1.1",
+			},
+		},
+		"ClientError": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.NotFound(w, r)
+			},
+			wantLevel: zapcore.WarnLevel,
+			want: &logtypepb.HttpRequest{
+				RequestMethod: "POST",
+				RequestUrl:    "http://example.com/path?q=1",
+				Status:        http.StatusNotFound,
+				ResponseSize:  19,
+				UserAgent:     "test-agent",
+				RemoteIp:      "192.0.2.1:1234",
+				Latency:       durationpb.New(500 * time.Millisecond),
+				Protocol:      "HTTP/1.1",
+			},
+		},
+		"ServerErrorWithTrace": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if len(TraceFromContext(r.Context())) == 0 {
+					t.Error("request context should carry the trace")
+				}
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			header: http.Header{
+				"X-Cloud-Trace-Context": {"105445aa7843bc8bf206b12000100000/1;o=1"},
+			},
+			wantLevel: zapcore.ErrorLevel,
+			want: &logtypepb.HttpRequest{
+				RequestMethod: "POST",
+				RequestUrl:    "http://example.com/path?q=1",
+				Status:        http.StatusServiceUnavailable,
+				UserAgent:     "test-agent",
+				RemoteIp:      "192.0.2.1:1234",
+				Latency:       durationpb.New(500 * time.Millisecond),
+				Protocol:      "HTTP/1.1",
+			},
+			wantTrace: true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			obs, logs := observer.New(zapcore.DebugLevel)
+			h := NewHTTPHandler(zap.New(obs), tt.handler).(*httpHandler)
+			now := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+			h.now = func() time.Time {
+				now = now.Add(500 * time.Millisecond)
+				return now
+			}
+
+			var body io.Reader
+			if tt.body != "" {
+				body = strings.NewReader(tt.body)
+			}
+			req := httptest.NewRequest("POST", "http://example.com/path?q=1", body)
+			req.RemoteAddr = "192.0.2.1:1234"
+			req.Header.Set("User-Agent", "test-agent")
+			for k, v := range tt.header {
+				req.Header[k] = v
+			}
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			entries := logs.AllUntimed()
+			if len(entries) != 1 {
+				t.Fatalf("got %d entries but want 1", len(entries))
+			}
+			ent := entries[0]
+			if ent.Level != tt.wantLevel {
+				t.Fatalf("got %v level but want %v", ent.Level, tt.wantLevel)
+			}
+			if got, want := ent.Message, "POST /path"; got != want {
+				t.Fatalf("got %q message but want %q", got, want)
+			}
+
+			if got := ent.Context[0].Key; got != HTTPRequestKey {
+				t.Fatalf("got %q field but want %q", got, HTTPRequestKey)
+			}
+			got := ent.Context[0].Interface.(*HTTPPayload).HttpRequest
+			if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+
+			span, ok := ent.ContextMap()[SpanKey]
+			if ok != tt.wantTrace {
+				t.Fatalf("got %t trace but want %t", ok, tt.wantTrace)
+			}
+			if tt.wantTrace && span != "0000000000000001" {
+				t.Fatalf("got %q span but want %q", span, "0000000000000001")
+			}
+		})
+	}
+}
+
+func TestHTTPHandlerPassthrough(t *testing.T) {
+	t.Parallel()
+
+	obs, logs := observer.New(zapcore.DebugLevel)
+	srv := httptest.NewServer(NewHTTPHandler(zap.New(obs), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := w.(http.Flusher); !ok {
+			t.Error("ResponseWriter should implement http.Flusher")
+		}
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Error(err)
+		}
+
+		conn, bufrw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		bufrw.WriteString("HTTP/1.1 101 Switching Protocols\r\nConnection: close\r\n\r\n") //nolint:errcheck
+		bufrw.Flush()                                                                      //nolint:errcheck
+	})))
+	t.Cleanup(srv.Close)
+
+	res, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	// the entry is written after the handler returns
+	deadline := time.Now().Add(5 * time.Second)
+	for logs.Len() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	entries := logs.AllUntimed()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries but want 1", len(entries))
+	}
+	if got := entries[0].Context[0].Interface.(*HTTPPayload).GetStatus(); got != http.StatusOK {
+		t.Fatalf("got %d status but want %d", got, http.StatusOK)
+	}
+}
+
+func TestResponseWriterNotSupported(t *testing.T) {
+	t.Parallel()
+
+	w := &responseWriter{ResponseWriter: struct{ http.ResponseWriter }{httptest.NewRecorder()}}
+	if _, _, err := w.Hijack(); !errors.Is(err, http.ErrNotSupported) {
+		t.Fatalf("got %v but want %v", err, http.ErrNotSupported)
+	}
+	if err := w.Push("/", nil); !errors.Is(err, http.ErrNotSupported) {
+		t.Fatalf("got %v but want %v", err, http.ErrNotSupported)
+	}
+	w.Flush()
+	if got := w.statusCode(); got != http.StatusOK {
+		t.Fatalf("got %d status but want %d", got, http.StatusOK)
+	}
+}
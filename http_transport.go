@@ -0,0 +1,225 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	logtypepb "google.golang.org/genproto/googleapis/logging/type"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zchee/zapcl/pkg/traceheader"
+)
+
+// httpTransport is the http.RoundTripper which logs the one "httpRequest" entry per outbound request.
+type httpTransport struct {
+	base    http.RoundTripper
+	logger  *zap.Logger
+	levelFn func(res *http.Response, err error) zapcore.Level
+	message func(r *http.Request) string
+	now     func() time.Time
+}
+
+// HTTPTransportOption configures a NewHTTPTransport.
+type HTTPTransportOption interface {
+	applyHTTPTransport(*httpTransport)
+}
+
+// httpTransportOptionFunc wraps a func so it satisfies the HTTPTransportOption interface.
+type httpTransportOptionFunc func(*httpTransport)
+
+func (f httpTransportOptionFunc) applyHTTPTransport(t *httpTransport) {
+	f(t)
+}
+
+// WithHTTPTransportLevel configures the func which picks the entry level from the response or the transport error.
+//
+// The default is HTTPResponseLevel.
+func WithHTTPTransportLevel(fn func(res *http.Response, err error) zapcore.Level) HTTPTransportOption {
+	return httpTransportOptionFunc(func(t *httpTransport) {
+		t.levelFn = fn
+	})
+}
+
+// WithHTTPTransportMessage configures the func which returns the entry message of the outbound request.
+//
+// The default message is the request method and URL, such as "GET https://example.com/index.html".
+func WithHTTPTransportMessage(fn func(r *http.Request) string) HTTPTransportOption {
+	return httpTransportOptionFunc(func(t *httpTransport) {
+		t.message = fn
+	})
+}
+
+// HTTPResponseLevel returns the entry level of the outbound request result.
+//
+// The transport errors are logged at ErrorLevel, and others are logged at the level of HTTPStatusLevel.
+func HTTPResponseLevel(res *http.Response, err error) zapcore.Level {
+	if err != nil {
+		return zapcore.ErrorLevel
+	}
+
+	return HTTPStatusLevel(res.StatusCode)
+}
+
+// NewHTTPTransport returns the http.RoundTripper which logs the one entry with the "httpRequest" and trace fields
+// per request sent by base. If base is nil, http.DefaultTransport is used.
+//
+// The trace of the request context is propagated by the traceparent and X-Cloud-Trace-Context headers, unless
+// the request already has them.
+//
+// The entry of the successful response is written when the response body is closed, so that the response size and
+// the latency cover the whole streamed body. The caller must close the response body as http.Client requires.
+func NewHTTPTransport(logger *zap.Logger, base http.RoundTripper, opts ...HTTPTransportOption) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	t := &httpTransport{
+		base:    base,
+		logger:  logger,
+		levelFn: HTTPResponseLevel,
+		message: func(r *http.Request) string {
+			return r.Method + " " + requestURL(r)
+		},
+		now: time.Now,
+	}
+	for _, opt := range opts {
+		opt.applyHTTPTransport(t)
+	}
+
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *httpTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := t.now()
+
+	// RoundTrip should not modify the request
+	req = req.Clone(req.Context())
+	tc, hasTrace := traceFromContext(req.Context())
+	if hasTrace {
+		traceheader.Inject(req.Header, traceheader.Trace{
+			TraceID: tc.traceID,
+			SpanID:  tc.spanID,
+			Sampled: tc.isSampled,
+		})
+	}
+
+	var reqBody *countingReadCloser
+	if req.Body != nil && req.Body != http.NoBody {
+		reqBody = &countingReadCloser{ReadCloser: req.Body}
+		req.Body = reqBody
+	}
+
+	l := &httpTransportLog{
+		transport: t,
+		req:       req,
+		reqBody:   reqBody,
+		start:     start,
+	}
+
+	res, err := t.base.RoundTrip(req)
+	if err != nil || res.Body == nil || res.Body == http.NoBody {
+		l.write(res, err, 0)
+		return res, err
+	}
+	// the body of the protocol switching response is the io.ReadWriteCloser which must not be wrapped
+	if res.StatusCode == http.StatusSwitchingProtocols {
+		l.write(res, nil, 0)
+		return res, nil
+	}
+
+	res.Body = &loggingBody{
+		ReadCloser: res.Body,
+		log:        l,
+		res:        res,
+	}
+
+	return res, nil
+}
+
+// httpTransportLog writes the entry of the outbound request.
+type httpTransportLog struct {
+	transport *httpTransport
+	req       *http.Request
+	reqBody   *countingReadCloser
+	start     time.Time
+}
+
+// write writes the entry of the outbound request with res or err.
+func (l *httpTransportLog) write(res *http.Response, err error, resSize int64) {
+	t := l.transport
+	ce := t.logger.Check(t.levelFn(res, err), t.message(l.req))
+	if ce == nil {
+		return
+	}
+
+	payload := &HTTPPayload{
+		HttpRequest: &logtypepb.HttpRequest{
+			RequestMethod: l.req.Method,
+			RequestUrl:    requestURL(l.req),
+			RequestSize:   l.req.ContentLength,
+			ResponseSize:  resSize,
+			UserAgent:     l.req.UserAgent(),
+			Referer:       l.req.Referer(),
+			Latency:       durationpb.New(t.now().Sub(l.start)),
+			Protocol:      l.req.Proto,
+		},
+	}
+	if l.reqBody != nil {
+		// the transport may still be writing the request body
+		if n := l.reqBody.n.Load(); n > payload.RequestSize {
+			payload.RequestSize = n
+		}
+	}
+	if res != nil {
+		payload.Status = int32(res.StatusCode)
+		payload.Protocol = res.Proto
+	}
+
+	fields := make([]zapcore.Field, 0, 5)
+	fields = append(fields, HTTP(payload))
+	fields = append(fields, TraceFromContext(l.req.Context())...)
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+	ce.Write(fields...)
+}
+
+// loggingBody is the response body which counts the read bytes and writes the entry when it is closed.
+type loggingBody struct {
+	io.ReadCloser
+
+	log  *httpTransportLog
+	res  *http.Response
+	n    int64
+	err  error // the read error other than io.EOF
+	once sync.Once
+}
+
+// Read implements io.Reader.
+func (b *loggingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	if err != nil && err != io.EOF { //nolint:errorlint // io.EOF is never wrapped
+		b.err = err
+	}
+
+	return n, err
+}
+
+// Close implements io.Closer.
+func (b *loggingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.log.write(b.res, b.err, b.n)
+	})
+
+	return err
+}
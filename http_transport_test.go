@@ -0,0 +1,132 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/zchee/zapcl/pkg/traceheader"
+)
+
+func TestHTTPTransport(t *testing.T) {
+	t.Parallel()
+
+	var gotHeader http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Clone()
+		io.Copy(io.Discard, r.Body) //nolint:errcheck
+		if r.URL.Path == "/error" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		io.WriteString(w, strings.Repeat("x", 1<<16)) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	obs, logs := observer.New(zapcore.DebugLevel)
+	client := &http.Client{Transport: NewHTTPTransport(zap.New(obs), nil)}
+
+	ctx := ContextWithTrace(context.Background(), "4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7", true)
+	req, err := http.NewRequestWithContext(ctx, "POST", srv.URL+"/ok", io.NopCloser(strings.NewReader("request body")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Header.Get(traceheader.TraceParentHeader) != "" {
+		t.Fatal("RoundTrip should not modify the request")
+	}
+	if got, want := gotHeader.Get(traceheader.TraceParentHeader), "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"; got != want {
+		t.Fatalf("got %q traceparent but want %q", got, want)
+	}
+	if got, want := gotHeader.Get(traceheader.CloudTraceContextHeader), "4bf92f3577b34da6a3ce929d0e0e4736/67667974448284343;o=1"; got != want {
+		t.Fatalf("got %q X-Cloud-Trace-Context but want %q", got, want)
+	}
+	if logs.Len() != 0 {
+		t.Fatal("entry should be written when the response body is closed")
+	}
+
+	if _, err := io.Copy(io.Discard, res.Body); err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	res.Body.Close()
+
+	entries := logs.TakeAll()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries but want 1", len(entries))
+	}
+	ent := entries[0]
+	if ent.Level != zapcore.InfoLevel {
+		t.Fatalf("got %v level but want %v", ent.Level, zapcore.InfoLevel)
+	}
+	payload := ent.Context[0].Interface.(*HTTPPayload)
+	if got, want := payload.GetRequestSize(), int64(len("request body")); got != want {
+		t.Fatalf("got %d request size but want %d", got, want)
+	}
+	if got, want := payload.GetResponseSize(), int64(1<<16); got != want {
+		t.Fatalf("got %d response size but want %d", got, want)
+	}
+	if got, want := payload.GetStatus(), int32(http.StatusOK); got != want {
+		t.Fatalf("got %d status but want %d", got, want)
+	}
+	if got, want := payload.GetProtocol(), "HTTP/1.1"; got != want {
+		t.Fatalf("got %q protocol but want %q", got, want)
+	}
+	if _, ok := ent.ContextMap()[TraceKey]; !ok {
+		t.Fatal("entry should have the trace field")
+	}
+
+	res, err = client.Get(srv.URL + "/error")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	entries = logs.TakeAll()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries but want 1", len(entries))
+	}
+	if got, want := entries[0].Level, zapcore.ErrorLevel; got != want {
+		t.Fatalf("got %v level but want %v", got, want)
+	}
+}
+
+type errRoundTripper struct{ err error }
+
+func (rt errRoundTripper) RoundTrip(*http.Request) (*http.Response, error) { return nil, rt.err }
+
+func TestHTTPTransportError(t *testing.T) {
+	t.Parallel()
+
+	obs, logs := observer.New(zapcore.DebugLevel)
+	wantErr := errors.New("connection refused")
+	client := &http.Client{Transport: NewHTTPTransport(zap.New(obs), errRoundTripper{err: wantErr})}
+
+	if _, err := client.Get("http://example.com/"); !errors.Is(err, wantErr) {
+		t.Fatalf("got %v but want %v", err, wantErr)
+	}
+
+	entries := logs.AllUntimed()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries but want 1", len(entries))
+	}
+	if got, want := entries[0].Level, zapcore.ErrorLevel; got != want {
+		t.Fatalf("got %v level but want %v", got, want)
+	}
+	if got, want := entries[0].ContextMap()["error"], wantErr.Error(); got != want {
+		t.Fatalf("got %q error but want %q", got, want)
+	}
+}
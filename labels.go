@@ -4,7 +4,9 @@
 package zapcl
 
 import (
-	"sync"
+	"errors"
+	"fmt"
+	"sort"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -16,54 +18,164 @@ const (
 	// labels field:
 	// - https://cloud.google.com/logging/docs/reference/v2/rest/v2/LogEntry#FIELDS.labels
 	LabelsKey = "logging.googleapis.com/labels"
+
+	// MaxLabelKeyLength is the maximum length of the label key in bytes.
+	MaxLabelKeyLength = 512
+
+	// MaxLabelValueLength is the maximum length of the label value in bytes.
+	MaxLabelValueLength = 64 << 10
 )
 
-// Label adds the Cloud Logging "labels" field from key and val.
-//
-// Cloud Logging truncates label keys that exceed 512 B and label values that exceed 64 KB upon their associated log entry being written.
-// The truncation is indicated by an ellipsis at the end of the character string.
-func Label(key, val string) zapcore.Field {
-	return zap.String("labels."+key, val)
-}
+var (
+	// ErrLabelKeyTooLong is the error of the label key which exceeds MaxLabelKeyLength.
+	ErrLabelKeyTooLong = errors.New("label key too long")
+
+	// ErrLabelValueTooLong is the error of the label value which exceeds MaxLabelValueLength.
+	ErrLabelValueTooLong = errors.New("label value too long")
+)
 
-// labelMap map of labels.
+// labelMap is the immutable set of labels.
 type labelMap struct {
-	m sync.Map
+	m   map[string]string
+	err error
 }
 
-// Add adds the val for a key.
-func (l *labelMap) Add(key, val string) {
-	l.m.Store(key, val)
+// newLabelMap returns the labelMap from keyvals.
+func newLabelMap(keyvals []string) *labelMap {
+	if len(keyvals)%2 != 0 {
+		panic("zapcl: keyvals length should be even")
+	}
+
+	l := &labelMap{
+		m: make(map[string]string, len(keyvals)/2),
+	}
+	for i := 0; i < len(keyvals); i += 2 {
+		l.add(keyvals[i], keyvals[i+1])
+	}
+
+	return l
 }
 
-// Delete deletes the value for a key.
-func (l *labelMap) Delete(key string) {
-	l.m.Delete(key)
+// add adds the val for a key, or records the error if key or val exceeds the Cloud Logging limits.
+func (l *labelMap) add(key, val string) {
+	switch {
+	case len(key) > MaxLabelKeyLength:
+		l.err = errors.Join(l.err, fmt.Errorf("%w: %d bytes key", ErrLabelKeyTooLong, len(key)))
+	case len(val) > MaxLabelValueLength:
+		l.err = errors.Join(l.err, fmt.Errorf("%w: %d bytes value of %q key", ErrLabelValueTooLong, len(val), key))
+	default:
+		l.m[key] = val
+	}
+}
+
+// merge returns the new labelMap which has the labels of l and src.
+//
+// The label of src overwrites the same key label of l.
+func (l *labelMap) merge(src *labelMap) *labelMap {
+	if l == nil {
+		return src
+	}
+	if src == nil {
+		return l
+	}
+
+	merged := &labelMap{
+		m:   make(map[string]string, len(l.m)+len(src.m)),
+		err: errors.Join(l.err, src.err),
+	}
+	for key, val := range l.m {
+		merged.m[key] = val
+	}
+	for key, val := range src.m {
+		merged.m[key] = val
+	}
+
+	return merged
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler.
+//
+// The labels are encoded in the key order, and the error of the invalid labels is returned after the valid labels
+// are encoded.
 func (l *labelMap) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	l.m.Range(func(key, val any) bool {
-		enc.AddString(key.(string), val.(string))
+	keys := make([]string, 0, len(l.m))
+	for key := range l.m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
 
-		return true
-	})
+	for _, key := range keys {
+		enc.AddString(key, l.m[key])
+	}
 
-	return nil
+	return l.err
 }
 
-// Label adds the Cloud Logging "labels" field from keyvals.
-func Labels(keyvals ...string) zapcore.Field {
-	if len(keyvals)%2 != 0 {
-		panic("keyval length should be powers of two")
+// labelsFromField returns the labelMap of the "labels" object field.
+func labelsFromField(field zapcore.Field) (*labelMap, bool) {
+	if field.Key != LabelsKey || field.Type != zapcore.ObjectMarshalerType {
+		return nil, false
+	}
+
+	return toLabelMap(field.Interface.(zapcore.ObjectMarshaler)), true
+}
+
+// toLabelMap converts obj to the labelMap.
+//
+// The object which is not created by Label or Labels is marshaled, and its values are formatted as the label values.
+func toLabelMap(obj zapcore.ObjectMarshaler) *labelMap {
+	if labels, ok := obj.(*labelMap); ok && labels != nil {
+		return labels
 	}
 
-	fields := new(labelMap)
-	for i := 0; i < len(keyvals)/2; i++ {
-		key := keyvals[i]
-		val := keyvals[i+1]
-		fields.Add(key, val)
+	enc := zapcore.NewMapObjectEncoder()
+	err := obj.MarshalLogObject(enc)
+
+	keys := make([]string, 0, len(enc.Fields))
+	for key := range enc.Fields {
+		keys = append(keys, key)
 	}
+	sort.Strings(keys)
 
-	return zap.Object(LabelsKey, fields)
+	labels := &labelMap{
+		m:   make(map[string]string, len(enc.Fields)),
+		err: err,
+	}
+	for _, key := range keys {
+		val, ok := enc.Fields[key].(string)
+		if !ok {
+			val = fmt.Sprint(enc.Fields[key])
+		}
+		labels.add(key, val)
+	}
+
+	return labels
+}
+
+// Label adds the Cloud Logging "labels" field from key and val.
+//
+// The labels of the entry are merged into the one "labels" field, see Labels.
+func Label(key, val string) zapcore.Field {
+	return Labels(key, val)
+}
+
+// Labels adds the Cloud Logging "labels" field from keyvals, which is the pairs of the label key and value.
+//
+// The labels added by zap.Logger.With, the log site fields and WithLabels are merged into the one "labels" field,
+// and the label of the later one overwrites the same key label.
+//
+// Cloud Logging truncates label keys that exceed 512 B and label values that exceed 64 KB upon their associated log
+// entry being written. Such labels are not added, and the "logging.googleapis.com/labelsError" field reports them
+// instead. Labels panics if the length of keyvals is odd.
+func Labels(keyvals ...string) zapcore.Field {
+	return zap.Object(LabelsKey, newLabelMap(keyvals))
+}
+
+// WithLabels configures the default labels of every entry from keyvals same as Labels.
+func WithLabels(keyvals ...string) Option {
+	labels := newLabelMap(keyvals)
+
+	return optionFunc(func(c *Core) {
+		c.labels = c.labels.merge(labels)
+	})
 }
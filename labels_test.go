@@ -4,11 +4,14 @@
 package zapcl
 
 import (
+	"bytes"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 func TestLabel(t *testing.T) {
@@ -16,7 +19,15 @@ func TestLabel(t *testing.T) {
 
 	field := Label("key", "value")
 
-	if diff := cmp.Diff(field, zap.String("labels.key", "value")); diff != "" {
+	enc := zapcore.NewMapObjectEncoder()
+	field.AddTo(enc)
+
+	want := map[string]interface{}{
+		LabelsKey: map[string]interface{}{
+			"key": "value",
+		},
+	}
+	if diff := cmp.Diff(want, enc.Fields); diff != "" {
 		t.Fatalf("(-want, +got)\n%s\n", diff)
 	}
 }
@@ -24,19 +35,114 @@ func TestLabel(t *testing.T) {
 func TestLabels(t *testing.T) {
 	t.Parallel()
 
-	field := Labels(
-		"hello", "world",
-		"hi", "universe",
-	)
+	tests := map[string]struct {
+		keyvals []string
+		want    map[string]interface{}
+	}{
+		"Pairs": {
+			keyvals: []string{
+				"hello", "world",
+				"hi", "universe",
+			},
+			want: map[string]interface{}{
+				LabelsKey: map[string]interface{}{
+					"hello": "world",
+					"hi":    "universe",
+				},
+			},
+		},
+		"TooLong": {
+			keyvals: []string{
+				strings.Repeat("k", MaxLabelKeyLength+1), "value",
+				"key", strings.Repeat("v", MaxLabelValueLength+1),
+				strings.Repeat("k", MaxLabelKeyLength), strings.Repeat("v", MaxLabelValueLength),
+			},
+			want: map[string]interface{}{
+				LabelsKey: map[string]interface{}{
+					strings.Repeat("k", MaxLabelKeyLength): strings.Repeat("v", MaxLabelValueLength),
+				},
+				LabelsKey + "Error": "label key too long: 513 bytes key\n" +
+					`label value too long: 65537 bytes value of "key" key`,
+			},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
 
-	labels := new(labelMap)
-	for key, val := range map[string]string{"hello": "world", "hi": "universe"} {
-		labels.Add(key, val)
+			enc := zapcore.NewMapObjectEncoder()
+			Labels(tt.keyvals...).AddTo(enc)
+
+			if diff := cmp.Diff(tt.want, enc.Fields); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
 	}
+}
 
-	if diff := cmp.Diff(field, zap.Object(LabelsKey, labels),
-		cmpopts.IgnoreUnexported(labelMap{}),
-	); diff != "" {
-		t.Fatalf("(-want, +got)\n%s\n", diff)
+func TestLabelsOddKeyvals(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Labels should panic with the odd keyvals")
+		}
+	}()
+	Labels("key")
+}
+
+func TestLabelsMerge(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := zap.New(NewCore(zapcore.AddSync(&buf), zapcore.DebugLevel,
+		WithLabels("env", "default", "team", "core"), WithErrorReport()))
+
+	child := logger.With(Labels("env", "test", "component", "api"))
+	child.With(Label("request", "1")).Info("merged", Label("component", "handler"), zap.String("key", "value"))
+	logger.Info("default")
+	logger.Info("plain", zap.Object(LabelsKey, zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		enc.AddString("raw", "label")
+		enc.AddInt("num", 1)
+		return nil
+	})))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines but want 3", len(lines))
+	}
+
+	want := []interface{}{
+		map[string]interface{}{
+			"env":       "test",
+			"team":      "core",
+			"component": "handler",
+			"request":   "1",
+		},
+		map[string]interface{}{
+			"env":  "default",
+			"team": "core",
+		},
+		// the labels object which is not created by Label or Labels is also merged
+		map[string]interface{}{
+			"env":  "default",
+			"team": "core",
+			"raw":  "label",
+			"num":  "1",
+		},
+	}
+	for i, line := range lines {
+		if got := strings.Count(line, `"`+LabelsKey+`":`); got != 1 {
+			t.Fatalf("line %d: got %d labels fields but want 1: %s", i, got, line)
+		}
+
+		var got map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want[i], got[LabelsKey]); diff != "" {
+			t.Fatalf("line %d: (-want, +got)\n%s\n", i, diff)
+		}
 	}
 }
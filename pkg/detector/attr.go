@@ -4,25 +4,24 @@
 package detector
 
 import (
-	"net"
-	"net/http"
+	"context"
 	"os"
-	"strings"
-	"time"
 	"unsafe"
-
-	"cloud.google.com/go/compute/metadata"
 )
 
 // ResourceAttributesFetcher abstracts environment lookup methods to query for environment variables, metadata attributes and file content.
+//
+// Metadata and LookupMetadata look up the metadata attribute until ctx is done. Metadata returns the empty string
+// on any error, and LookupMetadata returns the error same as MetadataClient.LookupMetadata.
 type ResourceAttributesFetcher interface {
 	EnvVar(name string) string
-	Metadata(path string) string
+	Metadata(ctx context.Context, path string) string
+	LookupMetadata(ctx context.Context, path string) (string, error)
 	ReadAll(path string) string
 }
 
 type resourceFetcher struct {
-	mdClient *metadata.Client
+	mdClient *MetadataClient
 }
 
 var _ ResourceAttributesFetcher = (*resourceFetcher)(nil)
@@ -32,14 +31,14 @@ func (g *resourceFetcher) EnvVar(name string) string {
 	return os.Getenv(name)
 }
 
-// Metadata uses metadata package Client.Get() to lookup for metadata attributes by path.
-func (g *resourceFetcher) Metadata(path string) string {
-	val, err := g.mdClient.Get(path)
-	if err != nil {
-		return ""
-	}
+// Metadata uses MetadataClient to lookup for metadata attributes by path.
+func (g *resourceFetcher) Metadata(ctx context.Context, path string) string {
+	return g.mdClient.Metadata(ctx, path)
+}
 
-	return strings.TrimSpace(val)
+// LookupMetadata uses MetadataClient to lookup for metadata attributes by path, and returns the lookup error.
+func (g *resourceFetcher) LookupMetadata(ctx context.Context, path string) (string, error) {
+	return g.mdClient.LookupMetadata(ctx, path)
 }
 
 // ReadAll reads all content of the file as a string.
@@ -52,18 +51,19 @@ func (g *resourceFetcher) ReadAll(path string) string {
 	return *(*string)(unsafe.Pointer(&data))
 }
 
-var fetcher = &resourceFetcher{
-	mdClient: metadata.NewClient(&http.Client{
-		Transport: &http.Transport{
-			Dial: (&net.Dialer{
-				Timeout:   1 * time.Second,
-				KeepAlive: 10 * time.Second,
-			}).Dial,
-		},
-	}),
-}
+var fetcher = NewResourceAttributes(NewMetadataClient())
 
 // ResourceAttributes provides read-only access to the ResourceAttributesFetcher interface implementation.
 func ResourceAttributes() ResourceAttributesFetcher {
 	return fetcher
 }
+
+// NewResourceAttributes returns the ResourceAttributesFetcher which looks up the metadata by md.
+//
+// Each metadata lookup is bounded by the context, or by the timeout of md, which is DefaultMetadataTimeout by default,
+// if the context has no deadline.
+func NewResourceAttributes(md *MetadataClient) ResourceAttributesFetcher {
+	return &resourceFetcher{
+		mdClient: md,
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package detector
+
+import "context"
+
+// List of Compute Engine metadata server paths:
+//
+// https://cloud.google.com/compute/docs/metadata/default-metadata-values
+const (
+	// MetadataProjectID is the project ID of the project the instance belongs to.
+	MetadataProjectID = "project/project-id"
+
+	// MetadataInstanceID is the numeric ID of the instance assigned by Compute Engine.
+	MetadataInstanceID = "instance/id"
+
+	// MetadataInstanceName is the name of the instance.
+	MetadataInstanceName = "instance/name"
+
+	// MetadataInstanceZone is the fully-qualified zone in which the instance is running, formatted as "projects/<number>/zones/<zone>".
+	MetadataInstanceZone = "instance/zone"
+
+	// MetadataInstanceRegion is the fully-qualified region in which the instance is running, formatted as "projects/<number>/regions/<region>".
+	//
+	// Note that this path is only served by the serverless platforms.
+	MetadataInstanceRegion = "instance/region"
+
+	// MetadataMachineType is the fully-qualified machine type of the instance, formatted as "projects/<number>/machineTypes/<type>".
+	MetadataMachineType = "instance/machine-type"
+)
+
+func (d *Detector) isGCE(ctx context.Context) bool {
+	// the machine-type is only served by the Compute Engine metadata server,
+	// not by the serverless platforms that emulate the metadata server.
+	machineType := d.attrs.Metadata(ctx, MetadataMachineType)
+
+	return machineType != ""
+}
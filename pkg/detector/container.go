@@ -3,12 +3,56 @@
 
 package detector
 
-// TODO(zchee): not implemented yet.
-func (d *Detector) isGCE() bool {
-	return false
-}
+import "context"
+
+// List of Kubernetes env vars:
+//
+// https://kubernetes.io/docs/concepts/services-networking/service/#environment-variables
+// https://kubernetes.io/docs/tasks/inject-data-application/environment-variable-expose-pod-information/
+const (
+	// EnvKubernetesServiceHost is the host of the Kubernetes API server. It is set on every container by the kubelet.
+	EnvKubernetesServiceHost = "KUBERNETES_SERVICE_HOST"
+
+	// EnvKubernetesPodName is the name of the pod exposed through the downward API.
+	//
+	// Set it with "valueFrom.fieldRef.fieldPath: metadata.name" in the container spec.
+	EnvKubernetesPodName = "POD_NAME"
+
+	// EnvKubernetesNamespaceName is the namespace of the pod exposed through the downward API.
+	//
+	// Set it with "valueFrom.fieldRef.fieldPath: metadata.namespace" in the container spec.
+	EnvKubernetesNamespaceName = "NAMESPACE_NAME"
+
+	// EnvKubernetesContainerName is the name of the container.
+	//
+	// There is no way to derive the container name from within the container, so it should be set in the container spec.
+	EnvKubernetesContainerName = "CONTAINER_NAME"
+
+	// EnvHostname is the hostname of the container. It defaults to the pod name on Kubernetes.
+	EnvHostname = "HOSTNAME"
+)
+
+// List of GKE metadata server paths:
+//
+// https://cloud.google.com/kubernetes-engine/docs/concepts/workload-identity#instance_metadata
+const (
+	// MetadataClusterName is the name of the GKE cluster the node belongs to.
+	MetadataClusterName = "instance/attributes/cluster-name"
+
+	// MetadataClusterLocation is the zone or region of the GKE cluster the node belongs to.
+	MetadataClusterLocation = "instance/attributes/cluster-location"
+)
+
+// KubernetesNamespacePath is the path of the file which contains the namespace of the pod.
+//
+// https://kubernetes.io/docs/tasks/run-application/access-api-from-pod/#directly-accessing-the-rest-api
+const KubernetesNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+func (d *Detector) isGKE(ctx context.Context) bool {
+	// avoid the metadata server round trip outside of Kubernetes
+	if d.attrs.EnvVar(EnvKubernetesServiceHost) == "" {
+		return false
+	}
 
-// TODO(zchee): not implemented yet.
-func (d *Detector) isGKE() bool {
-	return false
+	return d.attrs.Metadata(ctx, MetadataClusterName) != ""
 }
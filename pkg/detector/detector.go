@@ -3,6 +3,8 @@
 
 package detector
 
+import "context"
+
 // Platform represents a GCP service platforms.
 type Platform uint8
 
@@ -11,11 +13,9 @@ const (
 	UnknownPlatform Platform = iota
 
 	// GKE is the Kubernetes Engine platform.
-	// TODO(zchee): not implemented yet.
 	GKE
 
 	// GCE is the Google Compute Engine platform.
-	// TODO(zchee): not implemented yet.
 	GCE
 
 	// CloudRun is the Cloud Run platform.
@@ -34,6 +34,28 @@ const (
 	AppEngineFlex
 )
 
+// String returns the name of the platform.
+func (p Platform) String() string {
+	switch p {
+	case GKE:
+		return "GKE"
+	case GCE:
+		return "GCE"
+	case CloudRun:
+		return "CloudRun"
+	case CloudRunJobs:
+		return "CloudRunJobs"
+	case CloudFunctions:
+		return "CloudFunctions"
+	case AppEngineStandard:
+		return "AppEngineStandard"
+	case AppEngineFlex:
+		return "AppEngineFlex"
+	}
+
+	return "UnknownPlatform"
+}
+
 // Detector collects resource information for all GCP platforms.
 type Detector struct {
 	attrs ResourceAttributesFetcher
@@ -48,13 +70,15 @@ func NewDetector(attrs ResourceAttributesFetcher) *Detector {
 
 // CloudPlatform returns the platform on which this program is running.
 func (d *Detector) CloudPlatform() Platform {
-	switch {
-	case d.isGCE(): // TODO(zchee): not implemented yet.
-		return UnknownPlatform // GCE
-
-	case d.isGKE(): // TODO(zchee): not implemented yet.
-		return UnknownPlatform // GKE
+	return d.CloudPlatformWithContext(context.Background())
+}
 
+// CloudPlatformWithContext returns the platform on which this program is running.
+//
+// The metadata server is looked up until ctx is done. If ctx is done before the platform is detected, the platforms
+// which are detected by the metadata server are not detected.
+func (d *Detector) CloudPlatformWithContext(ctx context.Context) Platform {
+	switch {
 	case d.isCloudRun():
 		return CloudRun
 
@@ -69,6 +93,14 @@ func (d *Detector) CloudPlatform() Platform {
 
 	case d.isAppEngineFlex():
 		return AppEngineFlex
+
+	// the serverless platforms and GKE nodes also serve the metadata server,
+	// so the GKE and GCE detection must come after them.
+	case d.isGKE(ctx):
+		return GKE
+
+	case d.isGCE(ctx):
+		return GCE
 	}
 
 	return UnknownPlatform
@@ -4,6 +4,7 @@
 package detector
 
 import (
+	"context"
 	"testing"
 )
 
@@ -80,3 +81,89 @@ func TestCloudPlatformCloudFunctions(t *testing.T) {
 		t.Fatalf("got %d but want %d", platform, CloudFunctions)
 	}
 }
+
+func TestCloudPlatformGCE(t *testing.T) {
+	d := NewDetector(&fakeResourceGetter{
+		metaVars: map[string]string{
+			MetadataProjectID:    "foo",
+			MetadataInstanceID:   "1234567890",
+			MetadataInstanceZone: "projects/123456789012/zones/us-central1-a",
+			MetadataMachineType:  "projects/123456789012/machineTypes/e2-medium",
+		},
+	})
+	platform := d.CloudPlatform()
+
+	if platform != GCE {
+		t.Fatalf("got %d but want %d", platform, GCE)
+	}
+}
+
+func TestCloudPlatformCloudRunOnMetadataServer(t *testing.T) {
+	d := NewDetector(&fakeResourceGetter{
+		envVars: map[string]string{
+			EnvCloudRunService:  "foo",
+			EnvCloudRunRevision: "foo-001",
+			EnvCloudRunConfig:   "foo",
+		},
+		metaVars: map[string]string{
+			MetadataProjectID:   "foo",
+			MetadataMachineType: "projects/123456789012/machineTypes/e2-medium",
+		},
+	})
+	platform := d.CloudPlatform()
+
+	if platform != CloudRun {
+		t.Fatalf("got %d but want %d", platform, CloudRun)
+	}
+}
+
+func TestCloudPlatformGKE(t *testing.T) {
+	d := NewDetector(&fakeResourceGetter{
+		envVars: map[string]string{
+			EnvKubernetesServiceHost: "10.0.0.1",
+		},
+		metaVars: map[string]string{
+			MetadataProjectID:       "foo",
+			MetadataMachineType:     "projects/123456789012/machineTypes/e2-medium",
+			MetadataClusterName:     "foo",
+			MetadataClusterLocation: "us-central1",
+		},
+	})
+	platform := d.CloudPlatform()
+
+	if platform != GKE {
+		t.Fatalf("got %d but want %d", platform, GKE)
+	}
+}
+
+func TestCloudPlatformGCEWithoutKubernetes(t *testing.T) {
+	d := NewDetector(&fakeResourceGetter{
+		metaVars: map[string]string{
+			MetadataProjectID:       "foo",
+			MetadataMachineType:     "projects/123456789012/machineTypes/e2-medium",
+			MetadataClusterName:     "foo",
+			MetadataClusterLocation: "us-central1",
+		},
+	})
+	platform := d.CloudPlatform()
+
+	if platform != GCE {
+		t.Fatalf("got %d but want %d", platform, GCE)
+	}
+}
+
+func TestCloudPlatformWithContextDone(t *testing.T) {
+	d := NewDetector(&fakeResourceGetter{
+		metaVars: map[string]string{
+			MetadataProjectID:   "foo",
+			MetadataMachineType: "projects/123456789012/machineTypes/e2-medium",
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	platform := d.CloudPlatformWithContext(ctx)
+
+	if platform != UnknownPlatform {
+		t.Fatalf("got %d but want %d", platform, UnknownPlatform)
+	}
+}
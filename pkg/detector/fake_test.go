@@ -3,6 +3,8 @@
 
 package detector
 
+import "context"
+
 // fakeResourceGetter mocks internal.ResourceAtttributesGetter interface to retrieve env vars and metadata
 type fakeResourceGetter struct {
 	envVars  map[string]string
@@ -19,13 +21,21 @@ func (g *fakeResourceGetter) EnvVar(name string) string {
 	return ""
 }
 
-func (g *fakeResourceGetter) Metadata(path string) string {
+func (g *fakeResourceGetter) Metadata(ctx context.Context, path string) string {
+	v, _ := g.LookupMetadata(ctx, path)
+	return v
+}
+
+func (g *fakeResourceGetter) LookupMetadata(ctx context.Context, path string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 	if g.metaVars != nil {
 		if v, ok := g.metaVars[path]; ok {
-			return v
+			return v, nil
 		}
 	}
-	return ""
+	return "", ErrMetadataNotDefined
 }
 
 func (g *fakeResourceGetter) ReadAll(path string) string {
@@ -0,0 +1,222 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package detector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvMetadataHost is the environment variable which overrides the host of the metadata server.
+	EnvMetadataHost = "GCE_METADATA_HOST"
+
+	// DefaultMetadataHost is the host of the metadata server.
+	//
+	// The IP address is used instead of "metadata.google.internal" because it is documented as being stable, and the
+	// binaries built with the netgo tag do not know the search suffix of it.
+	DefaultMetadataHost = "169.254.169.254"
+
+	// DefaultMetadataTimeout is the deadline of the metadata lookup if the context has no deadline.
+	DefaultMetadataTimeout = 2 * time.Second
+)
+
+var (
+	// ErrNotOnGCP is returned by LookupMetadata if the metadata server is not reachable, which means this program is
+	// not running on Google Cloud.
+	ErrNotOnGCP = errors.New("detector: metadata server is not reachable")
+
+	// ErrMetadataNotDefined is returned by LookupMetadata if the metadata server does not serve the requested path.
+	ErrMetadataNotDefined = errors.New("detector: metadata is not defined")
+)
+
+// MetadataError is the error of the metadata lookup which is neither ErrNotOnGCP nor ErrMetadataNotDefined, such as
+// the deadline of the request or the unexpected response of the metadata server.
+//
+// These errors are usually transient, and the lookup should be retried later.
+type MetadataError struct {
+	// Path is the requested metadata path.
+	Path string
+
+	// StatusCode is the status code of the response, or 0 if there is no response.
+	StatusCode int
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements error.
+func (e *MetadataError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("detector: lookup %q metadata: %s: %v", e.Path, http.StatusText(e.StatusCode), e.Err)
+	}
+
+	return fmt.Sprintf("detector: lookup %q metadata: %v", e.Path, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *MetadataError) Unwrap() error {
+	return e.Err
+}
+
+// MetadataClient looks up the metadata server.
+type MetadataClient struct {
+	client  *http.Client
+	host    string
+	timeout time.Duration
+}
+
+// MetadataClientOption configures the MetadataClient.
+type MetadataClientOption interface {
+	applyMetadataClient(*MetadataClient)
+}
+
+type metadataClientOptionFunc func(*MetadataClient)
+
+func (f metadataClientOptionFunc) applyMetadataClient(c *MetadataClient) {
+	f(c)
+}
+
+// WithHTTPClient configures the http.Client which sends the requests to the metadata server.
+func WithHTTPClient(client *http.Client) MetadataClientOption {
+	return metadataClientOptionFunc(func(c *MetadataClient) {
+		c.client = client
+	})
+}
+
+// WithMetadataHost configures the host of the metadata server, such as "127.0.0.1:8080".
+//
+// It takes precedence over the GCE_METADATA_HOST environment variable.
+func WithMetadataHost(host string) MetadataClientOption {
+	return metadataClientOptionFunc(func(c *MetadataClient) {
+		c.host = host
+	})
+}
+
+// WithMetadataTimeout configures the deadline of the metadata lookup which is used if the context has no deadline.
+func WithMetadataTimeout(timeout time.Duration) MetadataClientOption {
+	return metadataClientOptionFunc(func(c *MetadataClient) {
+		c.timeout = timeout
+	})
+}
+
+// defaultHTTPClient is the http.Client of the metadata server which gives up the dial quickly, because the metadata
+// server is link-local, and not reachable outside of Google Cloud.
+var defaultHTTPClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   1 * time.Second,
+			KeepAlive: 10 * time.Second,
+		}).DialContext,
+	},
+}
+
+// NewMetadataClient returns the new MetadataClient.
+//
+// Unless WithMetadataHost is given, the host of the metadata server is the GCE_METADATA_HOST environment variable at
+// the time of the lookup, or DefaultMetadataHost if it is not set.
+func NewMetadataClient(opts ...MetadataClientOption) *MetadataClient {
+	c := &MetadataClient{
+		client:  defaultHTTPClient,
+		timeout: DefaultMetadataTimeout,
+	}
+	for _, opt := range opts {
+		opt.applyMetadataClient(c)
+	}
+
+	return c
+}
+
+// Metadata returns the metadata value of path, or the empty string if it is not available.
+func (c *MetadataClient) Metadata(ctx context.Context, path string) string {
+	val, err := c.LookupMetadata(ctx, path)
+	if err != nil {
+		return ""
+	}
+
+	return val
+}
+
+// LookupMetadata returns the metadata value of path.
+//
+// The returned error is ErrNotOnGCP if the metadata server is not reachable, ErrMetadataNotDefined if path is not
+// served, or *MetadataError otherwise.
+func (c *MetadataClient) LookupMetadata(ctx context.Context, path string) (string, error) {
+	if _, ok := ctx.Deadline(); !ok && c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	host := c.metadataHost()
+	url := "http://" + host + "/computeMetadata/v1/" + strings.TrimLeft(path, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", &MetadataError{Path: path, Err: err}
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		if ctx.Err() == nil && isUnreachable(err) {
+			return "", fmt.Errorf("%w: %w", ErrNotOnGCP, err)
+		}
+		return "", &MetadataError{Path: path, Err: err}
+	}
+	defer resp.Body.Close()
+
+	// the server which does not respond with the Metadata-Flavor header is not the metadata server
+	if resp.Header.Get("Metadata-Flavor") != "Google" {
+		return "", fmt.Errorf("%w: %s responds without Metadata-Flavor header", ErrNotOnGCP, host)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", &MetadataError{Path: path, StatusCode: resp.StatusCode, Err: err}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return strings.TrimSpace(string(body)), nil
+	case http.StatusNotFound:
+		return "", fmt.Errorf("%w: %s", ErrMetadataNotDefined, path)
+	}
+
+	return "", &MetadataError{Path: path, StatusCode: resp.StatusCode, Err: errors.New(strings.TrimSpace(string(body)))}
+}
+
+// metadataHost returns the host of the metadata server.
+func (c *MetadataClient) metadataHost() string {
+	if c.host != "" {
+		return c.host
+	}
+	if host := os.Getenv(EnvMetadataHost); host != "" {
+		return host
+	}
+
+	return DefaultMetadataHost
+}
+
+// isUnreachable reports whether err is the error of the host lookup or the connection, which means there is no
+// metadata server.
+func isUnreachable(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return !dnsErr.IsTimeout
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	return false
+}
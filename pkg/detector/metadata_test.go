@@ -0,0 +1,178 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package detector
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const projectID = "test-project"
+
+// newFakeMetadataServer returns the httptest.Server which serves the metadata of metaVars.
+func newFakeMetadataServer(t *testing.T, metaVars map[string]string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata-Flavor") != "Google" {
+			http.Error(w, "missing Metadata-Flavor header", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Metadata-Flavor", "Google")
+
+		switch path := strings.TrimPrefix(r.URL.Path, "/computeMetadata/v1/"); path {
+		case "slow":
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		case "flaky":
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		default:
+			val, ok := metaVars[path]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write([]byte(val + "\n"))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestMetadataClient(t *testing.T) {
+	t.Parallel()
+
+	srv := newFakeMetadataServer(t, map[string]string{
+		MetadataProjectID: projectID,
+	})
+
+	tests := map[string]struct {
+		path       string
+		want       string
+		wantErr    error
+		wantStatus int
+	}{
+		"Success": {
+			path: MetadataProjectID,
+			want: projectID,
+		},
+		"NotDefined": {
+			path:    MetadataInstanceID,
+			wantErr: ErrMetadataNotDefined,
+		},
+		"Flaky": {
+			path:       "flaky",
+			wantErr:    &MetadataError{},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		"Deadline": {
+			path:    "slow",
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewMetadataClient(WithMetadataHost(srv.Listener.Addr().String()), WithMetadataTimeout(50*time.Millisecond))
+
+			got, err := c.LookupMetadata(context.Background(), tt.path)
+			if got != tt.want {
+				t.Fatalf("got %q but want %q", got, tt.want)
+			}
+			if mdErr := (*MetadataError)(nil); errors.As(tt.wantErr, &mdErr) {
+				if !errors.As(err, &mdErr) || mdErr.StatusCode != tt.wantStatus {
+					t.Fatalf("got %v error but want *MetadataError with %d status", err, tt.wantStatus)
+				}
+			} else if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got %v error but want %v", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrNotOnGCP) {
+				t.Fatalf("got %v error but the metadata server is reachable", err)
+			}
+
+			if got := c.Metadata(context.Background(), tt.path); got != tt.want {
+				t.Fatalf("got %q by Metadata but want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetadataClientNotOnGCP(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.NotFoundHandler())
+		host := srv.Listener.Addr().String()
+		srv.Close()
+
+		c := NewMetadataClient(WithMetadataHost(host))
+		if _, err := c.LookupMetadata(context.Background(), MetadataProjectID); !errors.Is(err, ErrNotOnGCP) {
+			t.Fatalf("got %v error but want %v", err, ErrNotOnGCP)
+		}
+	})
+
+	t.Run("NotMetadataServer", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.NotFoundHandler())
+		t.Cleanup(srv.Close)
+
+		c := NewMetadataClient(WithMetadataHost(srv.Listener.Addr().String()))
+		if _, err := c.LookupMetadata(context.Background(), MetadataProjectID); !errors.Is(err, ErrNotOnGCP) {
+			t.Fatalf("got %v error but want %v", err, ErrNotOnGCP)
+		}
+	})
+}
+
+// countingTransport is the http.RoundTripper which counts the requests.
+type countingTransport struct {
+	requests atomic.Int64
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests.Add(1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestMetadataClientHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	srv := newFakeMetadataServer(t, map[string]string{
+		MetadataProjectID: projectID,
+	})
+
+	transport := &countingTransport{}
+	c := NewMetadataClient(WithHTTPClient(&http.Client{Transport: transport}), WithMetadataHost(srv.Listener.Addr().String()))
+	if got := c.Metadata(context.Background(), MetadataProjectID); got != projectID {
+		t.Fatalf("got %q but want %q", got, projectID)
+	}
+	if got := transport.requests.Load(); got != 1 {
+		t.Fatalf("got %d requests but want 1", got)
+	}
+}
+
+func TestMetadataClientEnvHost(t *testing.T) {
+	srv := newFakeMetadataServer(t, map[string]string{
+		MetadataProjectID: projectID,
+	})
+	t.Setenv(EnvMetadataHost, srv.Listener.Addr().String())
+
+	attrs := NewResourceAttributes(NewMetadataClient())
+	if got := attrs.Metadata(context.Background(), MetadataProjectID); got != projectID {
+		t.Fatalf("got %q but want %q", got, projectID)
+	}
+}
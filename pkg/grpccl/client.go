@@ -0,0 +1,145 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package grpccl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/zchee/zapcl"
+)
+
+// UnaryClientInterceptor returns the grpc.UnaryClientInterceptor which logs the one entry per unary RPC.
+//
+// The trace context of ctx is propagated by the traceparent and x-cloud-trace-context metadata, unless the outgoing
+// metadata already has them.
+func UnaryClientInterceptor(logger *zap.Logger, opts ...Option) grpc.UnaryClientInterceptor {
+	cfg := newConfig(opts)
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		start := cfg.now()
+		ctx = injectTrace(ctx)
+
+		p := new(peer.Peer)
+		err := invoker(ctx, method, req, reply, cc, append(callOpts, grpc.Peer(p))...)
+
+		if ce := logger.Check(cfg.levelFn(status.Code(err)), "finished unary call"); ce != nil {
+			// the request is sent once the RPC reaches the server, and the response is received only on success
+			var sent, received int64
+			if p.Addr != nil {
+				sent = 1
+			}
+			if err == nil {
+				received = 1
+			}
+
+			fields := rpcFields(kindClient, method, p)
+			fields = append(fields, resultFields(err, cfg.now().Sub(start), sent, received)...)
+			fields = append(fields, zapcl.TraceFromContext(ctx)...)
+			ce.Write(fields...)
+		}
+
+		return err
+	}
+}
+
+// StreamClientInterceptor returns the grpc.StreamClientInterceptor which logs the streaming RPC same as
+// StreamServerInterceptor.
+//
+// The end of the stream is logged when RecvMsg returns the error including io.EOF, or returns the single response of
+// the client-streaming RPC. The trace context of ctx is propagated same as UnaryClientInterceptor.
+func StreamClientInterceptor(logger *zap.Logger, opts ...Option) grpc.StreamClientInterceptor {
+	cfg := newConfig(opts)
+
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, callOpts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := cfg.now()
+		ctx = injectTrace(ctx)
+
+		log := &streamLog{
+			logger:   logger.With(append(rpcFields(kindClient, method, nil), zapcl.TraceFromContext(ctx)...)...),
+			id:       newStreamID(),
+			producer: method,
+		}
+
+		p := new(peer.Peer)
+		cs, err := streamer(ctx, desc, cc, method, append(callOpts, grpc.Peer(p))...)
+		if err != nil {
+			log.end(cfg.levelFn(status.Code(err)), err, cfg.now().Sub(start))
+			return nil, err
+		}
+		log.start()
+
+		return &clientStream{
+			ClientStream: cs,
+			cfg:          cfg,
+			desc:         desc,
+			log:          log,
+			peer:         p,
+			start:        start,
+		}, nil
+	}
+}
+
+// clientStream is the grpc.ClientStream which counts and logs the messages.
+type clientStream struct {
+	grpc.ClientStream
+
+	cfg   *config
+	desc  *grpc.StreamDesc
+	log   *streamLog
+	peer  *peer.Peer
+	start time.Time
+	once  sync.Once
+}
+
+// SendMsg implements grpc.ClientStream.
+func (s *clientStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if err == nil {
+		s.log.sent()
+	}
+
+	// the status of the failed stream is returned by RecvMsg
+	return err
+}
+
+// RecvMsg implements grpc.ClientStream.
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case err == nil:
+		s.log.received()
+		if !s.desc.ServerStreams {
+			s.finish(nil)
+		}
+	case errors.Is(err, io.EOF):
+		s.finish(nil)
+	default:
+		s.finish(err)
+	}
+
+	return err
+}
+
+// finish logs the end of the stream once.
+//
+// The peer is filled by the grpc.Peer call option when the underlying stream finishes, which is before finish is
+// called by RecvMsg.
+func (s *clientStream) finish(err error) {
+	s.once.Do(func() {
+		var fields []zap.Field
+		if s.peer.Addr != nil {
+			fields = append(fields, zap.String(PeerAddressKey, s.peer.Addr.String()))
+		}
+		s.log.end(s.cfg.levelFn(status.Code(err)), err, s.cfg.now().Sub(s.start), fields...)
+	})
+}
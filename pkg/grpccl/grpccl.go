@@ -0,0 +1,277 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package grpccl provides the gRPC server and client interceptors which log each RPC in the Cloud Logging format.
+//
+// The unary RPC is logged with the one entry when it finishes. The streaming RPC is logged with the entries grouped by
+// the Cloud Logging "operation" field, keyed by the per-stream ID.
+package grpccl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/zchee/zapcl"
+	"github.com/zchee/zapcl/pkg/traceheader"
+)
+
+// List of the field keys of the RPC entry.
+const (
+	// KindKey is the key of the RPC side, "server" or "client".
+	KindKey = "grpc.kind"
+
+	// ServiceKey is the key of the full service name.
+	ServiceKey = "grpc.service"
+
+	// MethodKey is the key of the method name.
+	MethodKey = "grpc.method"
+
+	// CodeKey is the key of the status code name.
+	CodeKey = "grpc.code"
+
+	// LatencyKey is the key of the RPC duration.
+	LatencyKey = "grpc.latency"
+
+	// SentMessagesKey is the key of the number of the sent messages.
+	SentMessagesKey = "grpc.sent_messages"
+
+	// ReceivedMessagesKey is the key of the number of the received messages.
+	ReceivedMessagesKey = "grpc.received_messages"
+
+	// PeerAddressKey is the key of the remote address.
+	PeerAddressKey = "peer.address"
+)
+
+const (
+	kindServer = "server"
+	kindClient = "client"
+)
+
+// config is the interceptor configuration.
+type config struct {
+	levelFn func(codes.Code) zapcore.Level
+	now     func() time.Time
+}
+
+// Option configures the interceptors.
+type Option interface {
+	apply(*config)
+}
+
+// optionFunc wraps a func so it satisfies the Option interface.
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) {
+	f(c)
+}
+
+// WithLevel configures the func which picks the entry level from the status code.
+//
+// The default is CodeLevel.
+func WithLevel(fn func(codes.Code) zapcore.Level) Option {
+	return optionFunc(func(c *config) {
+		c.levelFn = fn
+	})
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{
+		levelFn: CodeLevel,
+		now:     time.Now,
+	}
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+
+	return c
+}
+
+// CodeLevel returns the entry level of the status code, which zapcl maps to the Cloud Logging LogSeverity.
+//
+// The codes caused by the caller are logged at WarnLevel (WARNING), the codes caused by the server are logged at
+// ErrorLevel (ERROR), and OK is logged at InfoLevel (INFO).
+func CodeLevel(code codes.Code) zapcore.Level {
+	switch code {
+	case codes.OK:
+		return zapcore.InfoLevel
+
+	case codes.Canceled,
+		codes.InvalidArgument,
+		codes.NotFound,
+		codes.AlreadyExists,
+		codes.PermissionDenied,
+		codes.ResourceExhausted,
+		codes.FailedPrecondition,
+		codes.Aborted,
+		codes.OutOfRange,
+		codes.Unauthenticated:
+		return zapcore.WarnLevel
+	}
+
+	// Unknown, DeadlineExceeded, Unimplemented, Internal, Unavailable, DataLoss and undefined codes
+	return zapcore.ErrorLevel
+}
+
+// rpcFields returns the fields of the RPC method and the peer.
+func rpcFields(kind, fullMethod string, p *peer.Peer) []zapcore.Field {
+	service, method := path.Split(fullMethod)
+	fields := []zapcore.Field{
+		zap.String(KindKey, kind),
+		zap.String(ServiceKey, strings.Trim(service, "/")),
+		zap.String(MethodKey, method),
+	}
+	if p != nil && p.Addr != nil {
+		fields = append(fields, zap.String(PeerAddressKey, p.Addr.String()))
+	}
+
+	return fields
+}
+
+// resultFields returns the fields of the finished RPC.
+func resultFields(err error, latency time.Duration, sent, received int64) []zapcore.Field {
+	fields := []zapcore.Field{
+		zap.String(CodeKey, status.Code(err).String()),
+		zap.Duration(LatencyKey, latency),
+		zap.Int64(SentMessagesKey, sent),
+		zap.Int64(ReceivedMessagesKey, received),
+	}
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+	}
+
+	return fields
+}
+
+// peerFromContext returns the peer of ctx or nil.
+func peerFromContext(ctx context.Context) *peer.Peer {
+	p, _ := peer.FromContext(ctx)
+	return p
+}
+
+// traceMetadataKeys is the lowercase metadata keys of the trace context headers.
+var traceMetadataKeys = []string{
+	strings.ToLower(traceheader.TraceParentHeader),
+	strings.ToLower(traceheader.TraceStateHeader),
+	strings.ToLower(traceheader.CloudTraceContextHeader),
+}
+
+// extractTrace returns the copy of ctx which carries the trace context of the incoming metadata.
+//
+// ctx is returned as is if it already carries the trace, such as the OpenTelemetry span.
+func extractTrace(ctx context.Context) context.Context {
+	if _, _, _, ok := zapcl.TraceContextFromContext(ctx); ok {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	h := make(http.Header)
+	for _, key := range traceMetadataKeys {
+		for _, v := range md.Get(key) {
+			h.Add(key, v)
+		}
+	}
+	t, err := traceheader.FromHeader(h)
+	if err != nil {
+		return ctx
+	}
+
+	return zapcl.ContextWithTrace(ctx, t.TraceID, t.SpanID, t.Sampled)
+}
+
+// injectTrace returns the copy of ctx whose outgoing metadata carries the trace context of ctx.
+//
+// The trace context keys the outgoing metadata already has are kept as is.
+func injectTrace(ctx context.Context) context.Context {
+	traceID, spanID, isSampled, ok := zapcl.TraceContextFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	h := make(http.Header)
+	for _, key := range traceMetadataKeys {
+		for _, v := range md.Get(key) {
+			h.Add(key, v)
+		}
+	}
+	traceheader.Inject(h, traceheader.Trace{
+		TraceID: traceID,
+		SpanID:  spanID,
+		Sampled: isSampled,
+	})
+
+	var kv []string
+	for _, key := range traceMetadataKeys {
+		if len(md.Get(key)) > 0 {
+			continue
+		}
+		if v := h.Get(key); v != "" {
+			kv = append(kv, key, v)
+		}
+	}
+	if len(kv) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// streamLog writes the entries of the streaming RPC grouped by the operation.
+type streamLog struct {
+	logger   *zap.Logger
+	id       string
+	producer string
+
+	sentMsgs     atomic.Int64
+	receivedMsgs atomic.Int64
+}
+
+func (l *streamLog) start() {
+	l.logger.Info("started streaming call", zapcl.OperationStart(l.id, l.producer))
+}
+
+func (l *streamLog) sent() {
+	n := l.sentMsgs.Add(1)
+	if ce := l.logger.Check(zapcore.DebugLevel, "sent streaming message"); ce != nil {
+		ce.Write(zapcl.OperationCont(l.id, l.producer), zap.Int64(SentMessagesKey, n))
+	}
+}
+
+func (l *streamLog) received() {
+	n := l.receivedMsgs.Add(1)
+	if ce := l.logger.Check(zapcore.DebugLevel, "received streaming message"); ce != nil {
+		ce.Write(zapcl.OperationCont(l.id, l.producer), zap.Int64(ReceivedMessagesKey, n))
+	}
+}
+
+func (l *streamLog) end(lvl zapcore.Level, err error, latency time.Duration, extra ...zapcore.Field) {
+	if ce := l.logger.Check(lvl, "finished streaming call"); ce != nil {
+		fields := []zapcore.Field{zapcl.OperationEnd(l.id, l.producer)}
+		fields = append(fields, resultFields(err, latency, l.sentMsgs.Load(), l.receivedMsgs.Load())...)
+		ce.Write(append(fields, extra...)...)
+	}
+}
+
+// newStreamID returns the random ID of the stream operation.
+func newStreamID() string {
+	var b [16]byte
+	rand.Read(b[:]) //nolint:errcheck // crypto/rand.Read never fails on the supported platforms
+
+	return hex.EncodeToString(b[:])
+}
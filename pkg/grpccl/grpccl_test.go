@@ -0,0 +1,340 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package grpccl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/zchee/zapcl"
+	"github.com/zchee/zapcl/pkg/detector"
+	"github.com/zchee/zapcl/pkg/monitoredresource"
+)
+
+const (
+	testTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testSpanID  = "00f067aa0ba902b7"
+)
+
+func init() {
+	// resolve the trace project by the fake attributes, instead of looking up the metadata
+	// server of the machine running the tests.
+	monitoredresource.ResourceDetector = monitoredresource.NewResource(noPlatformAttributes{})
+}
+
+// noPlatformAttributes is the detector.ResourceAttributesFetcher which is not on any platform.
+type noPlatformAttributes struct{}
+
+func (noPlatformAttributes) EnvVar(string) string                    { return "" }
+func (noPlatformAttributes) Metadata(context.Context, string) string { return "" }
+func (noPlatformAttributes) ReadAll(string) string                   { return "" }
+
+func (noPlatformAttributes) LookupMetadata(context.Context, string) (string, error) {
+	return "", detector.ErrMetadataNotDefined
+}
+
+// fakeHealthServer is the health server which records the trace context of the handler context.
+type fakeHealthServer struct {
+	healthpb.UnimplementedHealthServer
+
+	traceIDs chan string
+}
+
+func (s *fakeHealthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	traceID, _, _, _ := zapcl.TraceContextFromContext(ctx)
+	s.traceIDs <- traceID
+
+	if req.GetService() == "missing" {
+		return nil, status.Error(codes.NotFound, "unknown service")
+	}
+
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+func (s *fakeHealthServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	traceID, _, _, _ := zapcl.TraceContextFromContext(stream.Context())
+	s.traceIDs <- traceID
+
+	for i := 0; i < 2; i++ {
+		if err := stream.Send(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setupInterceptors starts the fakeHealthServer with the server interceptors, and returns the client with the
+// client interceptors.
+func setupInterceptors(t *testing.T) (*fakeHealthServer, healthpb.HealthClient, *observer.ObservedLogs, *observer.ObservedLogs) {
+	t.Helper()
+
+	srvCore, srvLogs := observer.New(zapcore.DebugLevel)
+	cliCore, cliLogs := observer.New(zapcore.DebugLevel)
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryServerInterceptor(zap.New(srvCore))),
+		grpc.StreamInterceptor(StreamServerInterceptor(zap.New(srvCore))),
+	)
+	fake := &fakeHealthServer{traceIDs: make(chan string, 1)}
+	healthpb.RegisterHealthServer(srv, fake)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor(zap.New(cliCore))),
+		grpc.WithStreamInterceptor(StreamClientInterceptor(zap.New(cliCore))),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return fake, healthpb.NewHealthClient(conn), srvLogs, cliLogs
+}
+
+func TestUnaryInterceptors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		service       string
+		wantLevel     zapcore.Level
+		wantCode      string
+		wantResponses int64
+	}{
+		"OK": {
+			wantLevel:     zapcore.InfoLevel,
+			wantCode:      "OK",
+			wantResponses: 1,
+		},
+		"NotFound": {
+			service:       "missing",
+			wantLevel:     zapcore.WarnLevel,
+			wantCode:      "NotFound",
+			wantResponses: 0,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fake, client, srvLogs, cliLogs := setupInterceptors(t)
+
+			ctx := zapcl.ContextWithTrace(context.Background(), testTraceID, testSpanID, true)
+			_, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: tt.service})
+			if got := status.Code(err).String(); got != tt.wantCode {
+				t.Fatalf("got %s code but want %s", got, tt.wantCode)
+			}
+			if got := <-fake.traceIDs; got != testTraceID {
+				t.Fatalf("got %q trace ID in the handler but want %q", got, testTraceID)
+			}
+
+			for side, logs := range map[string]*observer.ObservedLogs{kindServer: srvLogs, kindClient: cliLogs} {
+				entries := logs.AllUntimed()
+				if len(entries) != 1 {
+					t.Fatalf("%s: got %d entries but want 1", side, len(entries))
+				}
+				ent := entries[0]
+				if ent.Level != tt.wantLevel {
+					t.Fatalf("%s: got %v level but want %v", side, ent.Level, tt.wantLevel)
+				}
+
+				sent, received := int64(1), tt.wantResponses
+				if side == kindServer {
+					sent, received = received, sent
+				}
+
+				fields := ent.ContextMap()
+				want := map[string]interface{}{
+					KindKey:             side,
+					ServiceKey:          "grpc.health.v1.Health",
+					MethodKey:           "Check",
+					CodeKey:             tt.wantCode,
+					SentMessagesKey:     sent,
+					ReceivedMessagesKey: received,
+					zapcl.SpanKey:       testSpanID,
+				}
+				for key, want := range want {
+					if diff := cmp.Diff(want, fields[key]); diff != "" {
+						t.Fatalf("%s: %s: (-want, +got)\n%s\n", side, key, diff)
+					}
+				}
+				if _, ok := fields[PeerAddressKey]; !ok {
+					t.Fatalf("%s: entry has no %s field", side, PeerAddressKey)
+				}
+			}
+		})
+	}
+}
+
+func TestStreamInterceptors(t *testing.T) {
+	t.Parallel()
+
+	fake, client, srvLogs, cliLogs := setupInterceptors(t)
+
+	ctx := zapcl.ContextWithTrace(context.Background(), testTraceID, testSpanID, true)
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		if _, err := stream.Recv(); err != nil {
+			if !errors.Is(err, io.EOF) {
+				t.Fatal(err)
+			}
+			break
+		}
+	}
+	if got := <-fake.traceIDs; got != testTraceID {
+		t.Fatalf("got %q trace ID in the handler but want %q", got, testTraceID)
+	}
+
+	tests := map[string]struct {
+		logs         *observer.ObservedLogs
+		wantMessages []string
+		wantSent     int64
+		wantReceived int64
+	}{
+		kindServer: {
+			logs: srvLogs,
+			wantMessages: []string{
+				"started streaming call",
+				"received streaming message",
+				"sent streaming message",
+				"sent streaming message",
+				"finished streaming call",
+			},
+			wantSent:     2,
+			wantReceived: 1,
+		},
+		kindClient: {
+			logs: cliLogs,
+			wantMessages: []string{
+				"started streaming call",
+				"sent streaming message",
+				"received streaming message",
+				"received streaming message",
+				"finished streaming call",
+			},
+			wantSent:     1,
+			wantReceived: 2,
+		},
+	}
+	for side, tt := range tests {
+		entries := tt.logs.AllUntimed()
+
+		var msgs []string
+		var opID string
+		for i, ent := range entries {
+			msgs = append(msgs, ent.Message)
+
+			op, ok := ent.ContextMap()[zapcl.OperationKey].(map[string]interface{})
+			if !ok {
+				t.Fatalf("%s: entry %d has no %s field", side, i, zapcl.OperationKey)
+			}
+			if i == 0 {
+				opID = op["id"].(string)
+			}
+			if op["id"] != opID {
+				t.Fatalf("%s: got %v operation ID but want %v", side, op["id"], opID)
+			}
+			if got, want := op["first"], i == 0; got != want {
+				t.Fatalf("%s: got %v first but want %v", side, got, want)
+			}
+			if got, want := op["last"], i == len(entries)-1; got != want {
+				t.Fatalf("%s: got %v last but want %v", side, got, want)
+			}
+		}
+		if diff := cmp.Diff(tt.wantMessages, msgs); diff != "" {
+			t.Fatalf("%s: (-want, +got)\n%s\n", side, diff)
+		}
+
+		last := entries[len(entries)-1].ContextMap()
+		if got := last[SentMessagesKey]; got != tt.wantSent {
+			t.Fatalf("%s: got %v sent messages but want %d", side, got, tt.wantSent)
+		}
+		if got := last[ReceivedMessagesKey]; got != tt.wantReceived {
+			t.Fatalf("%s: got %v received messages but want %d", side, got, tt.wantReceived)
+		}
+		if got := last[CodeKey]; got != "OK" {
+			t.Fatalf("%s: got %v code but want OK", side, got)
+		}
+		if _, ok := last[PeerAddressKey]; !ok {
+			t.Fatalf("%s: last entry has no %s field", side, PeerAddressKey)
+		}
+	}
+
+	if srvLogs.AllUntimed()[0].ContextMap()[zapcl.OperationKey].(map[string]interface{})["id"] ==
+		cliLogs.AllUntimed()[0].ContextMap()[zapcl.OperationKey].(map[string]interface{})["id"] {
+		t.Fatal("server and client streams should have the different operation IDs")
+	}
+}
+
+func TestUnaryClientInterceptorNotSent(t *testing.T) {
+	t.Parallel()
+
+	_, client, _, cliLogs := setupInterceptors(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{}); status.Code(err) != codes.Canceled {
+		t.Fatalf("got %v error but want Canceled", err)
+	}
+
+	entries := cliLogs.AllUntimed()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries but want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if got := fields[SentMessagesKey]; got != int64(0) {
+		t.Fatalf("got %v sent messages but want 0", got)
+	}
+	if got := fields[ReceivedMessagesKey]; got != int64(0) {
+		t.Fatalf("got %v received messages but want 0", got)
+	}
+}
+
+func TestCodeLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := map[codes.Code]zapcore.Level{
+		codes.OK:                zapcore.InfoLevel,
+		codes.Canceled:          zapcore.WarnLevel,
+		codes.InvalidArgument:   zapcore.WarnLevel,
+		codes.PermissionDenied:  zapcore.WarnLevel,
+		codes.Unauthenticated:   zapcore.WarnLevel,
+		codes.Unknown:           zapcore.ErrorLevel,
+		codes.DeadlineExceeded:  zapcore.ErrorLevel,
+		codes.Internal:          zapcore.ErrorLevel,
+		codes.Unavailable:       zapcore.ErrorLevel,
+		codes.DataLoss:          zapcore.ErrorLevel,
+		codes.Code(100):         zapcore.ErrorLevel,
+		codes.ResourceExhausted: zapcore.WarnLevel,
+	}
+	for code, want := range tests {
+		if got := CodeLevel(code); got != want {
+			t.Errorf("CodeLevel(%v): got %v but want %v", code, got, want)
+		}
+	}
+}
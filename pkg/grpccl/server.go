@@ -0,0 +1,111 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package grpccl
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/zchee/zapcl"
+)
+
+// UnaryServerInterceptor returns the grpc.UnaryServerInterceptor which logs the one entry per unary RPC.
+//
+// The trace context of the incoming metadata is stored in the handler context, so that the handler can correlate
+// its own logs by zapcl.WithContext.
+func UnaryServerInterceptor(logger *zap.Logger, opts ...Option) grpc.UnaryServerInterceptor {
+	cfg := newConfig(opts)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := cfg.now()
+		ctx = extractTrace(ctx)
+
+		resp, err := handler(ctx, req)
+
+		if ce := logger.Check(cfg.levelFn(status.Code(err)), "finished unary call"); ce != nil {
+			// the response is sent only on success
+			var sent int64
+			if err == nil {
+				sent = 1
+			}
+
+			fields := rpcFields(kindServer, info.FullMethod, peerFromContext(ctx))
+			fields = append(fields, resultFields(err, cfg.now().Sub(start), sent, 1)...)
+			fields = append(fields, zapcl.TraceFromContext(ctx)...)
+			ce.Write(fields...)
+		}
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns the grpc.StreamServerInterceptor which logs the streaming RPC.
+//
+// The start and the end of the stream are logged with zapcl.OperationStart and zapcl.OperationEnd, and each message
+// is logged at DebugLevel with zapcl.OperationCont. The operation ID is the per-stream random ID, and the producer is
+// the full method name.
+func StreamServerInterceptor(logger *zap.Logger, opts ...Option) grpc.StreamServerInterceptor {
+	cfg := newConfig(opts)
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := cfg.now()
+		ctx := extractTrace(ss.Context())
+
+		s := &serverStream{
+			ServerStream: ss,
+			ctx:          ctx,
+			log: &streamLog{
+				logger: logger.With(append(
+					rpcFields(kindServer, info.FullMethod, peerFromContext(ctx)),
+					zapcl.TraceFromContext(ctx)...,
+				)...),
+				id:       newStreamID(),
+				producer: info.FullMethod,
+			},
+		}
+		s.log.start()
+
+		err := handler(srv, s)
+
+		s.log.end(cfg.levelFn(status.Code(err)), err, cfg.now().Sub(start))
+
+		return err
+	}
+}
+
+// serverStream is the grpc.ServerStream which counts and logs the messages.
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+	log *streamLog
+}
+
+// Context returns the stream context which carries the trace context.
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg implements grpc.ServerStream.
+func (s *serverStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.log.sent()
+	}
+
+	return err
+}
+
+// RecvMsg implements grpc.ServerStream.
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.log.received()
+	}
+
+	return err
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package monitoredresource
+
+import (
+	"os"
+
+	mrpb "google.golang.org/genproto/googleapis/api/monitoredres"
+)
+
+// hostname returns the host name reported by the kernel. It is a variable for testing.
+var hostname = os.Hostname
+
+// config is the configuration of the fallback MonitoredResource.
+type config struct {
+	projectID string
+	location  string
+	namespace string
+	job       string
+	errorHook func(error)
+}
+
+// Option configures the fallback MonitoredResource which is used when the platform could not be detected.
+type Option interface {
+	apply(*config)
+}
+
+// optionFunc wraps a func so it satisfies the Option interface.
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) {
+	f(c)
+}
+
+// WithProjectID configures the project_id label of the fallback resource.
+//
+// If not set, the project ID is looked up from the metadata server.
+func WithProjectID(projectID string) Option {
+	return optionFunc(func(c *config) {
+		c.projectID = projectID
+	})
+}
+
+// WithLocation configures the location label of the generic_node and generic_task resources, such as "us-east1-a" or "aws:us-east-1a".
+func WithLocation(location string) Option {
+	return optionFunc(func(c *config) {
+		c.location = location
+	})
+}
+
+// WithNamespace configures the namespace label of the generic_node and generic_task resources, such as a cluster name.
+func WithNamespace(namespace string) Option {
+	return optionFunc(func(c *config) {
+		c.namespace = namespace
+	})
+}
+
+// WithJob configures the job label of the generic_task resource, such as the name of a microservice or distributed batch job.
+//
+// If set, the fallback resource is generic_task instead of generic_node.
+func WithJob(job string) Option {
+	return optionFunc(func(c *config) {
+		c.job = job
+	})
+}
+
+// WithErrorHook configures the hook which is called with the error of Detect, such as the detection failure or the
+// validation error of the detected resource.
+//
+// DetectWithContext also calls hook before it returns the error.
+func WithErrorHook(hook func(error)) Option {
+	return optionFunc(func(c *config) {
+		c.errorHook = hook
+	})
+}
+
+// fallback returns the MonitoredResource for the process which is not running on any known platform.
+//
+// The fallback chain is:
+//   - generic_task if the job is configured
+//   - generic_node if the location or namespace is configured
+//   - global otherwise
+//
+// The node_id and task_id labels are the host name.
+func fallback(cfg *config, projectID string) *MonitoredResource {
+	if cfg.projectID != "" {
+		projectID = cfg.projectID
+	}
+
+	if cfg.job == "" && cfg.location == "" && cfg.namespace == "" {
+		return &MonitoredResource{
+			LogID: "stdout",
+			MonitoredResource: &mrpb.MonitoredResource{
+				Type: string(Global),
+				Labels: Label{
+					"project_id": projectID,
+				},
+			},
+		}
+	}
+
+	host, err := hostname()
+	if err != nil {
+		host = ""
+	}
+
+	if cfg.job != "" {
+		return &MonitoredResource{
+			LogID: "stdout",
+			MonitoredResource: &mrpb.MonitoredResource{
+				Type: string(GenericTask),
+				Labels: Label{
+					"project_id": projectID,
+					"location":   cfg.location,
+					"namespace":  cfg.namespace,
+					"job":        cfg.job,
+					"task_id":    host,
+				},
+			},
+		}
+	}
+
+	return &MonitoredResource{
+		LogID: "stdout",
+		MonitoredResource: &mrpb.MonitoredResource{
+			Type: string(GenericNode),
+			Labels: Label{
+				"project_id": projectID,
+				"location":   cfg.location,
+				"namespace":  cfg.namespace,
+				"node_id":    host,
+			},
+		},
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Command gen generates the monitored resource types, their descriptors and constructors from the resource descriptor
+// file.
+//
+// Usage:
+//
+//	go run ./internal/gen -o resources_gen.go resources.json
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// resource is the monitored resource type in the descriptor file.
+type resource struct {
+	Type        string  `json:"type"`
+	Name        string  `json:"name"`
+	DisplayName string  `json:"displayName"`
+	Description string  `json:"description"`
+	Labels      []label `json:"labels"`
+}
+
+// label is the label of the monitored resource type in the descriptor file.
+type label struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+	Optional    bool   `json:"optional"`
+	Format      string `json:"format"`
+}
+
+// FormatConst returns the LabelFormat constant of the label format.
+func (l label) FormatConst() string {
+	return formats[l.Format]
+}
+
+// Param returns the parameter name of the label in the constructor, such as "clusterName" for "cluster_name".
+func (l label) Param() string {
+	var b strings.Builder
+	for i, word := range strings.Split(l.Key, "_") {
+		switch {
+		case i == 0:
+			b.WriteString(word)
+		case initialisms[word] != "":
+			b.WriteString(initialisms[word])
+		default:
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+
+	return b.String()
+}
+
+// initialisms is the list of the words which are written in the consistent case in the parameter names.
+var initialisms = map[string]string{
+	"api":   "API",
+	"dicom": "DICOM",
+	"dns":   "DNS",
+	"fhir":  "FHIR",
+	"hl7v2": "HL7v2",
+	"http":  "HTTP",
+	"https": "HTTPS",
+	"id":    "ID",
+	"ssl":   "SSL",
+	"uid":   "UID",
+	"url":   "URL",
+	"uuid":  "UUID",
+}
+
+// formats is the map of the label formats in the descriptor file to the LabelFormat constants.
+var formats = map[string]string{
+	"aws_region":   "AWSRegionFormat",
+	"gcp_location": "GCPLocationFormat",
+	"gcp_region":   "GCPRegionFormat",
+	"gcp_zone":     "GCPZoneFormat",
+	"location":     "LocationFormat",
+}
+
+var tmpl = template.Must(template.New("resources").Parse(`// Code generated by internal/gen from {{.Source}}. DO NOT EDIT.
+
+package monitoredresource
+
+// List of Monitored resource types.
+//
+//	https://cloud.google.com/logging/docs/api/v2/resource-list
+const (
+{{- range .Resources}}
+	// {{.Name}} is the {{.DisplayName}} monitored resource type.
+	//
+	// {{.Description}}
+	{{.Name}} Type = {{printf "%q" .Type}}
+{{end -}}
+)
+
+// descriptors is the map of the monitored resource types to their descriptors.
+var descriptors = map[Type]*Descriptor{
+{{- range .Resources}}
+	{{.Name}}: {
+		Type:        {{.Name}},
+		DisplayName: {{printf "%q" .DisplayName}},
+		Description: {{printf "%q" .Description}},
+		Labels: []LabelDescriptor{
+		{{- range .Labels}}
+			{Key: {{printf "%q" .Key}}, Description: {{printf "%q" .Description}}
+			{{- if .Optional}}, Optional: true{{end}}
+			{{- if .Format}}, Format: {{.FormatConst}}{{end}}},
+		{{- end}}
+		},
+	},
+{{- end}}
+}
+{{range .Resources}}
+// New{{.Name}} returns the new {{.DisplayName}} MonitoredResource.
+//
+// The labels are:
+{{- range .Labels}}
+//   - {{.Key}}{{if .Optional}} (optional){{end}}: {{.Description}}
+{{- end}}
+func New{{.Name}}({{range $i, $l := .Labels}}{{if $i}}, {{end}}{{$l.Param}}{{end}} string) (*MonitoredResource, error) {
+	return New({{.Name}}, Label{
+	{{- range .Labels}}
+		{{printf "%q" .Key}}: {{.Param}},
+	{{- end}}
+	})
+}
+{{end}}`))
+
+func main() {
+	out := flag.String("o", "resources_gen.go", "output file")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		log.Fatal("usage: gen [-o output] <descriptor file>")
+	}
+
+	if err := run(flag.Arg(0), *out); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(src, out string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	var resources []resource
+	if err := json.Unmarshal(data, &resources); err != nil {
+		return fmt.Errorf("parse %s: %w", src, err)
+	}
+	if err := check(resources); err != nil {
+		return fmt.Errorf("%s: %w", src, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]interface{}{
+		"Source":    src,
+		"Resources": resources,
+	}); err != nil {
+		return err
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("format generated code: %w", err)
+	}
+
+	return os.WriteFile(out, code, 0o644)
+}
+
+// check reports the duplicated or incomplete resources.
+func check(resources []resource) error {
+	types := make(map[string]bool)
+	names := make(map[string]bool)
+	for _, r := range resources {
+		if r.Type == "" || r.Name == "" || r.DisplayName == "" {
+			return fmt.Errorf("resource %q has no type, name or display name", r.Type)
+		}
+		if types[r.Type] || names[r.Name] {
+			return fmt.Errorf("resource %q (%s) is duplicated", r.Type, r.Name)
+		}
+		types[r.Type] = true
+		names[r.Name] = true
+
+		if len(r.Labels) == 0 {
+			return fmt.Errorf("resource %q has no labels", r.Type)
+		}
+		keys := make(map[string]bool)
+		for _, l := range r.Labels {
+			if keys[l.Key] {
+				return fmt.Errorf("label %q of resource %q is duplicated", l.Key, r.Type)
+			}
+			if l.Format != "" && formats[l.Format] == "" {
+				return fmt.Errorf("label %q of resource %q has unknown format %q", l.Key, r.Type, l.Format)
+			}
+			keys[l.Key] = true
+		}
+	}
+
+	return nil
+}
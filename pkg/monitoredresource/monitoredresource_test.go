@@ -4,7 +4,9 @@
 package monitoredresource
 
 import (
-	"sync"
+	"context"
+	"errors"
+	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -17,8 +19,8 @@ import (
 const (
 	there               = "anyvalue"
 	projectID           = "test-project"
-	zoneID              = "test-region-zone"
-	regionID            = "test-region"
+	zoneID              = "us-central1-a"
+	regionID            = "us-central1"
 	serviceName         = "test-service"
 	version             = "1.0"
 	instanceName        = "test-12345"
@@ -57,13 +59,21 @@ func (g *fakeResourceGetter) EnvVar(name string) string {
 	return ""
 }
 
-func (g *fakeResourceGetter) Metadata(path string) string {
+func (g *fakeResourceGetter) Metadata(ctx context.Context, path string) string {
+	v, _ := g.LookupMetadata(ctx, path)
+	return v
+}
+
+func (g *fakeResourceGetter) LookupMetadata(ctx context.Context, path string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 	if g.metaVars != nil {
 		if v, ok := g.metaVars[path]; ok {
-			return v
+			return v, nil
 		}
 	}
-	return ""
+	return "", detector.ErrMetadataNotDefined
 }
 
 func (g *fakeResourceGetter) ReadAll(path string) string {
@@ -75,16 +85,14 @@ func (g *fakeResourceGetter) ReadAll(path string) string {
 	return ""
 }
 
-// setupDetectResource resets sync.Once on detectResource and enforces mocked resource attribute getter
+// setupDetectResource resets the cached resolution and enforces mocked resource attribute getter
 func setupDetectedResource(envVars, metaVars, fsPaths map[string]string) {
-	ResourceDetector.once = new(sync.Once)
 	fake := &fakeResourceGetter{
 		envVars:  envVars,
 		metaVars: metaVars,
 		fsPaths:  fsPaths,
 	}
-	ResourceDetector.attrs = fake
-	ResourceDetector.pb = nil
+	ResourceDetector = NewResource(fake)
 }
 
 func TestResourceDetection(t *testing.T) {
@@ -101,7 +109,7 @@ func TestResourceDetection(t *testing.T) {
 				detector.EnvCloudFunctionsTarget:        funcTarget,
 				detector.EnvCloudFunctionsSignatureType: funcSignature,
 				detector.EnvCloudFunctionsKService:      serviceName,
-				detector.EnvCloudRunRevision:            regionID,
+				detector.EnvCloudRunRevision:            version,
 			},
 			metaVars: map[string]string{
 				"":                   there,
@@ -169,6 +177,165 @@ func TestResourceDetection(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "AppEngineStandard",
+			envVars: map[string]string{
+				detector.EnvAppEngineEnv:          "standard",
+				detector.EnvAppEngineFlexService:  serviceName,
+				detector.EnvAppEngineFlexVersion:  version,
+				detector.EnvAppEngineFlexInstance: instanceID,
+			},
+			metaVars: map[string]string{
+				"":                   there,
+				"project/project-id": projectID,
+				"instance/zone":      qualifiedZoneName,
+			},
+			want: &MonitoredResource{
+				LogID: "appengine.googleapis.com%2Frequest_log",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "gae_app",
+					Labels: map[string]string{
+						"project_id": projectID,
+						"module_id":  serviceName,
+						"version_id": version,
+						"zone":       zoneID,
+					},
+				},
+			},
+		},
+		{
+			name: "AppEngineFlex",
+			envVars: map[string]string{
+				detector.EnvAppEngineFlexService:  serviceName,
+				detector.EnvAppEngineFlexVersion:  version,
+				detector.EnvAppEngineFlexInstance: instanceID,
+			},
+			metaVars: map[string]string{
+				"":                   there,
+				"project/project-id": projectID,
+				"instance/zone":      qualifiedZoneName,
+			},
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "gae_app",
+					Labels: map[string]string{
+						"project_id": projectID,
+						"module_id":  serviceName,
+						"version_id": version,
+						"zone":       zoneID,
+					},
+				},
+			},
+		},
+		{
+			name: "GCE",
+			metaVars: map[string]string{
+				"":                      there,
+				"project/project-id":    projectID,
+				"instance/id":           instanceID,
+				"instance/zone":         qualifiedZoneName,
+				"instance/machine-type": "projects/" + projectID + "/machineTypes/e2-medium",
+			},
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "gce_instance",
+					Labels: map[string]string{
+						"project_id":  projectID,
+						"instance_id": instanceID,
+						"zone":        zoneID,
+					},
+				},
+			},
+		},
+		{
+			name: "GKEContainer",
+			envVars: map[string]string{
+				detector.EnvKubernetesServiceHost:   there,
+				detector.EnvKubernetesPodName:       podName,
+				detector.EnvKubernetesContainerName: containerName,
+			},
+			metaVars: map[string]string{
+				"":                                     there,
+				"project/project-id":                   projectID,
+				"instance/zone":                        qualifiedZoneName,
+				"instance/machine-type":                "projects/" + projectID + "/machineTypes/e2-medium",
+				"instance/attributes/cluster-name":     clusterName,
+				"instance/attributes/cluster-location": regionID,
+			},
+			fsPaths: map[string]string{
+				"/var/run/secrets/kubernetes.io/serviceaccount/namespace": namespaceName + "\n",
+			},
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "k8s_container",
+					Labels: map[string]string{
+						"project_id":     projectID,
+						"location":       regionID,
+						"cluster_name":   clusterName,
+						"namespace_name": namespaceName,
+						"pod_name":       podName,
+						"container_name": containerName,
+					},
+				},
+			},
+		},
+		{
+			name: "GKEPod",
+			envVars: map[string]string{
+				detector.EnvKubernetesServiceHost:   there,
+				detector.EnvHostname:                podName,
+				detector.EnvKubernetesNamespaceName: namespaceName,
+			},
+			metaVars: map[string]string{
+				"":                                 there,
+				"project/project-id":               projectID,
+				"instance/zone":                    qualifiedZoneName,
+				"instance/machine-type":            "projects/" + projectID + "/machineTypes/e2-medium",
+				"instance/attributes/cluster-name": clusterName,
+			},
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "k8s_pod",
+					Labels: map[string]string{
+						"project_id":     projectID,
+						"location":       zoneID,
+						"cluster_name":   clusterName,
+						"namespace_name": namespaceName,
+						"pod_name":       podName,
+					},
+				},
+			},
+		},
+		{
+			name: "GKENode",
+			envVars: map[string]string{
+				detector.EnvKubernetesServiceHost: there,
+			},
+			metaVars: map[string]string{
+				"":                                     there,
+				"project/project-id":                   projectID,
+				"instance/name":                        instanceName,
+				"instance/machine-type":                "projects/" + projectID + "/machineTypes/e2-medium",
+				"instance/attributes/cluster-name":     clusterName,
+				"instance/attributes/cluster-location": regionID,
+			},
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "k8s_node",
+					Labels: map[string]string{
+						"project_id":   projectID,
+						"location":     regionID,
+						"cluster_name": clusterName,
+						"node_name":    instanceName,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -182,6 +349,161 @@ func TestResourceDetection(t *testing.T) {
 	}
 }
 
+func TestResourceDetectionFallback(t *testing.T) {
+	hostname = func() (string, error) { return instanceName, nil }
+	t.Cleanup(func() { hostname = os.Hostname })
+
+	tests := []struct {
+		name     string
+		envVars  map[string]string
+		metaVars map[string]string
+		opts     []Option
+		want     *MonitoredResource
+		wantErr  bool
+	}{
+		{
+			name: "Global",
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "global",
+					Labels: map[string]string{
+						"project_id": "",
+					},
+				},
+			},
+		},
+		{
+			name: "GlobalWithProjectID",
+			opts: []Option{WithProjectID(projectID)},
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "global",
+					Labels: map[string]string{
+						"project_id": projectID,
+					},
+				},
+			},
+		},
+		{
+			name: "GenericNode",
+			opts: []Option{WithProjectID(projectID), WithLocation(regionID), WithNamespace(namespaceName)},
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "generic_node",
+					Labels: map[string]string{
+						"project_id": projectID,
+						"location":   regionID,
+						"namespace":  namespaceName,
+						"node_id":    instanceName,
+					},
+				},
+			},
+		},
+		{
+			name: "GenericTask",
+			opts: []Option{WithProjectID(projectID), WithLocation(regionID), WithNamespace(namespaceName), WithJob(serviceName)},
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "generic_task",
+					Labels: map[string]string{
+						"project_id": projectID,
+						"location":   regionID,
+						"namespace":  namespaceName,
+						"job":        serviceName,
+						"task_id":    instanceName,
+					},
+				},
+			},
+		},
+		{
+			name: "CloudRunWithoutMetadata",
+			envVars: map[string]string{
+				detector.EnvCloudRunConfig:   crConfig,
+				detector.EnvCloudRunService:  serviceName,
+				detector.EnvCloudRunRevision: version,
+			},
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: "global",
+					Labels: map[string]string{
+						"project_id": "",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupDetectedResource(tt.envVars, tt.metaVars, nil)
+			got, err := DetectWithContext(context.Background(), tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err %v but wantErr %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(got, tt.want, cmpopts.IgnoreUnexported(mrpb.MonitoredResource{})); diff != "" {
+				t.Errorf("got(-),want(+):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDetectInvalidResource(t *testing.T) {
+	setupDetectedResource(nil, map[string]string{
+		"":                      there,
+		"project/project-id":    projectID,
+		"instance/id":           instanceID,
+		"instance/zone":         "projects/" + projectID + "/zones/invalid zone",
+		"instance/machine-type": "projects/" + projectID + "/machineTypes/e2-medium",
+	}, nil)
+
+	var hookErr error
+	got := Detect(WithJob(serviceName), WithLocation("invalid location"), WithErrorHook(func(err error) {
+		hookErr = err
+	}))
+
+	if !errors.Is(hookErr, ErrInvalidLabel) {
+		t.Fatalf("got %v error but want %v", hookErr, ErrInvalidLabel)
+	}
+	// both the detected gce_instance and the generic_task fallback are invalid
+	want := &MonitoredResource{
+		LogID: "stdout",
+		MonitoredResource: &mrpb.MonitoredResource{
+			Type: "global",
+			Labels: map[string]string{
+				"project_id": projectID,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(mrpb.MonitoredResource{})); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+}
+
+func TestDetectWithContextCanceled(t *testing.T) {
+	setupDetectedResource(nil, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err := DetectWithContext(ctx, WithProjectID(projectID))
+	if err == nil {
+		// the detection may win the race against the canceled context
+		return
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v but want %v", err, context.Canceled)
+	}
+	if got == nil || got.Type != string(Global) {
+		t.Fatalf("got %v but want the %s fallback resource", got, Global)
+	}
+}
+
 // var benchmarkResultHolder *mrpb.MonitoredResource
 //
 // func BenchmarkDetectResource(b *testing.B) {
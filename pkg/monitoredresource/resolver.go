@@ -0,0 +1,278 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package monitoredresource
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zchee/zapcl/pkg/detector"
+)
+
+// EnvGoogleCloudProject is the environment variable which overrides the project ID of the metadata server.
+const EnvGoogleCloudProject = "GOOGLE_CLOUD_PROJECT"
+
+// DefaultResolveTimeout is the deadline of the resolution which is started by the Resource accessors such as
+// ProjectID.
+const DefaultResolveTimeout = 5 * time.Second
+
+// DefaultResolveRetryInterval is the interval in which the Resource accessors do not start the new resolution after
+// the resolution is abandoned.
+const DefaultResolveRetryInterval = time.Minute
+
+// Attributes is the resource attributes of the platform on which this program is running.
+//
+// The attributes which are not available on the platform are empty.
+type Attributes struct {
+	Platform   detector.Platform
+	ProjectID  string
+	InstanceID string
+	Zone       string
+	Region     string
+}
+
+// merge overwrites the attributes of a by the non-empty attributes of override.
+func (a *Attributes) merge(override *Attributes) {
+	if override.Platform != detector.UnknownPlatform {
+		a.Platform = override.Platform
+	}
+	if override.ProjectID != "" {
+		a.ProjectID = override.ProjectID
+	}
+	if override.InstanceID != "" {
+		a.InstanceID = override.InstanceID
+	}
+	if override.Zone != "" {
+		a.Zone = override.Zone
+	}
+	if override.Region != "" {
+		a.Region = override.Region
+	}
+}
+
+// resolution is the result of the resolution.
+type resolution struct {
+	attrs Attributes
+	res   *MonitoredResource
+}
+
+// failedResolution is the partial resolution which is abandoned, and used by the accessors until retryAt.
+type failedResolution struct {
+	resolution
+	retryAt time.Time
+}
+
+// resolveCall is the in-flight resolution, which is shared by the concurrent callers.
+type resolveCall struct {
+	done chan struct{}
+
+	// err is the error of the abandoned resolution, which is set before done is closed
+	err error
+}
+
+// Resource resolves the resource attributes and the platform specific MonitoredResource once, and caches them.
+//
+// The resolution looks up the metadata server, so it is started by the first call of Resolve or the accessors, and
+// shared by the concurrent callers. The accessors wait for it up to DefaultResolveTimeout, and return the attributes
+// looked up until then if it is not complete. They return the same attributes without starting the new resolution for
+// DefaultResolveRetryInterval after that, unless Resolve or Refresh completes it in the meantime. Call Refresh to
+// resolve them again for the long-lived process.
+//
+// The metadata lookups of the resolution are bounded by the context of the caller which started it. If the context
+// is done, the resolution is abandoned without caching, and the other callers start it again with their own context.
+type Resource struct {
+	attrs detector.ResourceAttributesFetcher
+
+	cache    atomic.Pointer[resolution]
+	failed   atomic.Pointer[failedResolution]
+	override atomic.Pointer[Attributes]
+
+	// timeout and retryInterval are DefaultResolveTimeout and DefaultResolveRetryInterval, which are shortened by
+	// the tests
+	timeout       time.Duration
+	retryInterval time.Duration
+
+	mu      sync.Mutex
+	pending *resolveCall
+}
+
+// ResourceDetector is the Resource which resolves the attributes of the running platform.
+var ResourceDetector = NewResource(detector.ResourceAttributes())
+
+// NewResource returns the new Resource which resolves the attributes by attrs.
+func NewResource(attrs detector.ResourceAttributesFetcher) *Resource {
+	return &Resource{
+		attrs:         attrs,
+		timeout:       DefaultResolveTimeout,
+		retryInterval: DefaultResolveRetryInterval,
+	}
+}
+
+// Override configures the attributes which take precedence over the resolved attributes.
+//
+// The empty attributes of override are ignored. The project ID is also overridden by the GOOGLE_CLOUD_PROJECT
+// environment variable, and override takes precedence over it.
+//
+// Override takes effect from the next resolution, so it should be called before the first use or followed by Refresh.
+func (r *Resource) Override(override Attributes) {
+	r.override.Store(&override)
+}
+
+// Resolve returns the resolved attributes.
+//
+// If the attributes are not resolved yet, Resolve waits for the resolution until ctx is done, and returns ctx.Err().
+// The resolution started by Resolve is abandoned when ctx is done, and started again by the next call.
+func (r *Resource) Resolve(ctx context.Context) (Attributes, error) {
+	res, err := r.resolve(ctx, false)
+	if err != nil {
+		return Attributes{}, err
+	}
+
+	return res.attrs, nil
+}
+
+// Refresh resolves the attributes again, and returns them.
+//
+// The cached attributes are used until the new resolution is complete. If ctx is done before that, Refresh returns
+// ctx.Err().
+func (r *Resource) Refresh(ctx context.Context) (Attributes, error) {
+	res, err := r.resolve(ctx, true)
+	if err != nil {
+		return Attributes{}, err
+	}
+
+	return res.attrs, nil
+}
+
+// ProjectID returns the resolved project ID.
+func (r *Resource) ProjectID() string {
+	return r.attributes().ProjectID
+}
+
+// InstanceID returns the numeric ID of the Compute Engine instance.
+func (r *Resource) InstanceID() string {
+	return r.attributes().InstanceID
+}
+
+// Zone returns the resolved zone, such as "us-central1-a".
+func (r *Resource) Zone() string {
+	return r.attributes().Zone
+}
+
+// Region returns the resolved region, such as "us-central1".
+func (r *Resource) Region() string {
+	return r.attributes().Region
+}
+
+// attributes returns the resolved attributes with DefaultResolveTimeout, or the attributes of the abandoned resolution
+// until its retry time.
+func (r *Resource) attributes() Attributes {
+	if res := r.cache.Load(); res != nil {
+		return res.attrs
+	}
+	failed := r.failed.Load()
+	if failed != nil && time.Now().Before(failed.retryAt) {
+		return failed.attrs
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	attrs, err := r.Resolve(ctx)
+	if err == nil {
+		return attrs
+	}
+	// the abandoned resolution stores its partial attributes when its lookups return, so back off from now on even if
+	// it has not stored them yet
+	r.failed.CompareAndSwap(failed, &failedResolution{retryAt: time.Now().Add(r.retryInterval)})
+
+	return r.failed.Load().attrs
+}
+
+// resolve returns the cached resolution, or waits for the new resolution if there is no cache or refresh is true.
+func (r *Resource) resolve(ctx context.Context, refresh bool) (*resolution, error) {
+	for {
+		if res := r.cache.Load(); res != nil && !refresh {
+			return res, nil
+		}
+
+		r.mu.Lock()
+		if res := r.cache.Load(); res != nil && !refresh {
+			r.mu.Unlock()
+			return res, nil
+		}
+		call := r.pending
+		if call == nil {
+			call = &resolveCall{done: make(chan struct{})}
+			r.pending = call
+			go r.lookup(ctx, call)
+		}
+		r.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-call.done:
+		}
+		if call.err == nil {
+			return r.cache.Load(), nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// the resolution is abandoned by the caller which started it, so start it again with ctx
+	}
+}
+
+// lookup resolves the attributes until ctx is done, caches them, and closes the done channel of call.
+func (r *Resource) lookup(ctx context.Context, call *resolveCall) {
+	a := &Attributes{
+		Platform:  detector.NewDetector(r.attrs).CloudPlatformWithContext(ctx),
+		ProjectID: r.attrs.EnvVar(EnvGoogleCloudProject),
+	}
+	if a.ProjectID == "" {
+		a.ProjectID = r.attrs.Metadata(ctx, detector.MetadataProjectID)
+	}
+	if a.Platform != detector.UnknownPlatform {
+		a.InstanceID = r.attrs.Metadata(ctx, detector.MetadataInstanceID)
+		a.Zone = lastPathElem(r.attrs.Metadata(ctx, detector.MetadataInstanceZone))
+		a.Region = lastPathElem(r.attrs.Metadata(ctx, detector.MetadataInstanceRegion))
+	}
+	if override := r.override.Load(); override != nil {
+		a.merge(override)
+	}
+	res := r.detectResource(ctx, a)
+
+	// the attributes looked up after ctx is done are incomplete
+	if err := ctx.Err(); err != nil {
+		call.err = err
+		r.failed.Store(&failedResolution{
+			resolution: resolution{
+				attrs: *a,
+				res:   res,
+			},
+			retryAt: time.Now().Add(r.retryInterval),
+		})
+	} else {
+		r.cache.Store(&resolution{
+			attrs: *a,
+			res:   res,
+		})
+		r.failed.Store(nil)
+	}
+
+	r.mu.Lock()
+	r.pending = nil
+	r.mu.Unlock()
+	close(call.done)
+}
+
+// lastPathElem returns the last element of the fully qualified metadata path, such as
+// "projects/123/zones/us-central1-a".
+func lastPathElem(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
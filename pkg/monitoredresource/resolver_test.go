@@ -0,0 +1,281 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package monitoredresource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/zchee/zapcl/pkg/detector"
+)
+
+// countingResourceGetter is the fakeResourceGetter which counts the metadata lookups, and blocks them until unblock
+// is closed or the context is done if unblock is not nil.
+type countingResourceGetter struct {
+	fakeResourceGetter
+
+	mu       sync.Mutex
+	lookups  atomic.Int64
+	unblock  chan struct{}
+	metaVars map[string]string
+}
+
+func (g *countingResourceGetter) Metadata(ctx context.Context, path string) string {
+	v, _ := g.LookupMetadata(ctx, path)
+	return v
+}
+
+func (g *countingResourceGetter) LookupMetadata(ctx context.Context, path string) (string, error) {
+	g.lookups.Add(1)
+	if g.unblock != nil {
+		select {
+		case <-g.unblock:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	v, ok := g.metaVars[path]
+	if !ok {
+		return "", detector.ErrMetadataNotDefined
+	}
+
+	return v, nil
+}
+
+func (g *countingResourceGetter) setMetadata(path, val string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.metaVars[path] = val
+}
+
+// gceMetadata returns the metadata of the Compute Engine instance.
+func gceMetadata() map[string]string {
+	return map[string]string{
+		"":                            there,
+		detector.MetadataProjectID:    projectID,
+		detector.MetadataInstanceID:   instanceID,
+		detector.MetadataInstanceZone: qualifiedZoneName,
+		detector.MetadataMachineType:  there,
+	}
+}
+
+func TestResourceResolve(t *testing.T) {
+	t.Parallel()
+
+	fake := &countingResourceGetter{metaVars: gceMetadata()}
+	r := NewResource(fake)
+
+	want := Attributes{
+		Platform:   detector.GCE,
+		ProjectID:  projectID,
+		InstanceID: instanceID,
+		Zone:       zoneID,
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if got := r.ProjectID(); got != projectID {
+				t.Errorf("got %q project ID but want %q", got, projectID)
+			}
+		}()
+	}
+	wg.Wait()
+
+	lookups := fake.lookups.Load()
+	got, err := r.Resolve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+	if r.Zone() != zoneID || r.InstanceID() != instanceID || r.Region() != "" {
+		t.Fatalf("got %q zone, %q instance ID and %q region", r.Zone(), r.InstanceID(), r.Region())
+	}
+
+	// the attributes are resolved only once
+	if got := fake.lookups.Load(); got != lookups {
+		t.Fatalf("got %d metadata lookups after the resolution but want %d", got, lookups)
+	}
+}
+
+func TestResourceResolveOverride(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Env", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &countingResourceGetter{
+			fakeResourceGetter: fakeResourceGetter{
+				envVars: map[string]string{EnvGoogleCloudProject: "env-project"},
+			},
+			metaVars: gceMetadata(),
+		}
+		r := NewResource(fake)
+
+		if got := r.ProjectID(); got != "env-project" {
+			t.Fatalf("got %q project ID but want %q", got, "env-project")
+		}
+	})
+
+	t.Run("Override", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &countingResourceGetter{
+			fakeResourceGetter: fakeResourceGetter{
+				envVars: map[string]string{EnvGoogleCloudProject: "env-project"},
+			},
+			metaVars: gceMetadata(),
+		}
+		r := NewResource(fake)
+		r.Override(Attributes{ProjectID: "override-project", Region: regionID})
+
+		want := Attributes{
+			Platform:   detector.GCE,
+			ProjectID:  "override-project",
+			InstanceID: instanceID,
+			Zone:       zoneID,
+			Region:     regionID,
+		}
+		got, err := r.Resolve(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("(-want, +got)\n%s\n", diff)
+		}
+	})
+}
+
+func TestResourceRefresh(t *testing.T) {
+	t.Parallel()
+
+	fake := &countingResourceGetter{metaVars: gceMetadata()}
+	r := NewResource(fake)
+
+	if got := r.Zone(); got != zoneID {
+		t.Fatalf("got %q zone but want %q", got, zoneID)
+	}
+
+	fake.setMetadata("instance/zone", "projects/"+projectID+"/zones/migrated-zone")
+	if got := r.Zone(); got != zoneID {
+		t.Fatalf("got %q zone before Refresh but want the cached %q", got, zoneID)
+	}
+
+	got, err := r.Refresh(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Zone != "migrated-zone" || r.Zone() != "migrated-zone" {
+		t.Fatalf("got %q zone after Refresh but want %q", got.Zone, "migrated-zone")
+	}
+}
+
+func TestResourceResolveDeadline(t *testing.T) {
+	t.Parallel()
+
+	fake := &countingResourceGetter{
+		metaVars: gceMetadata(),
+		unblock:  make(chan struct{}),
+	}
+	r := NewResource(fake)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := r.Resolve(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v but want %v", err, context.DeadlineExceeded)
+	}
+
+	// the resolution is abandoned after the deadline without waiting for the blocked lookup
+	for start := time.Now(); ; time.Sleep(time.Millisecond) {
+		r.mu.Lock()
+		pending := r.pending
+		r.mu.Unlock()
+		if pending == nil {
+			break
+		}
+		if time.Since(start) > time.Second {
+			t.Fatal("the resolution is still running after the deadline")
+		}
+	}
+	if r.cache.Load() != nil {
+		t.Fatal("the abandoned resolution should not be cached")
+	}
+
+	// and started again by the next call
+	close(fake.unblock)
+	got, err := r.Resolve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ProjectID != projectID || got.Platform != detector.GCE {
+		t.Fatalf("got %q project ID on %s but want %q on %s", got.ProjectID, got.Platform, projectID, detector.GCE)
+	}
+}
+
+func TestResourceAccessorsBackoff(t *testing.T) {
+	t.Parallel()
+
+	fake := &countingResourceGetter{
+		metaVars: gceMetadata(),
+		unblock:  make(chan struct{}),
+	}
+	r := NewResource(fake)
+	r.timeout = 10 * time.Millisecond
+	r.retryInterval = time.Hour
+
+	if got := r.ProjectID(); got != "" {
+		t.Fatalf("got %q project ID of the abandoned resolution but want empty", got)
+	}
+	for start := time.Now(); ; time.Sleep(time.Millisecond) {
+		r.mu.Lock()
+		pending := r.pending
+		r.mu.Unlock()
+		if pending == nil {
+			break
+		}
+		if time.Since(start) > time.Second {
+			t.Fatal("the resolution is still running after the deadline")
+		}
+	}
+	lookups := fake.lookups.Load()
+
+	// the accessors should return the abandoned resolution without starting the new one until the retry time
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		if got := r.Zone(); got != "" {
+			t.Fatalf("got %q zone of the abandoned resolution but want empty", got)
+		}
+	}
+	if elapsed := time.Since(start); elapsed >= r.timeout {
+		t.Fatalf("the accessors blocked for %s after the abandoned resolution", elapsed)
+	}
+	if got := fake.lookups.Load(); got != lookups {
+		t.Fatalf("got %d metadata lookups but want %d", got, lookups)
+	}
+
+	// and Refresh should resolve them again
+	close(fake.unblock)
+	if _, err := r.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := r.ProjectID(); got != projectID {
+		t.Fatalf("got %q project ID after Refresh but want %q", got, projectID)
+	}
+}
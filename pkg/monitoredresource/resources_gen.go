@@ -0,0 +1,5900 @@
+// Code generated by internal/gen from resources.json. DO NOT EDIT.
+
+package monitoredresource
+
+// List of Monitored resource types.
+//
+//	https://cloud.google.com/logging/docs/api/v2/resource-list
+const (
+	// AIPlatformEndpoint is the Vertex AI Endpoint monitored resource type.
+	//
+	// A Vertex AI API Endpoint where Models are deployed into it.
+	AIPlatformEndpoint Type = "aiplatform.googleapis.com/Endpoint"
+
+	// AIPlatformFeaturestore is the Vertex AI Feature Store monitored resource type.
+	//
+	// A Vertex AI Feature Store.
+	AIPlatformFeaturestore Type = "aiplatform.googleapis.com/Featurestore"
+
+	// AIPlatformIndexEndpoint is the Matching Engine Index Endpoint monitored resource type.
+	//
+	// An Endpoint to which Matching Engine Indexes are deployed.
+	AIPlatformIndexEndpoint Type = "aiplatform.googleapis.com/IndexEndpoint"
+
+	// AIPlatformPipelineJob is the Vertex Pipelines Job monitored resource type.
+	//
+	// A Vertex Pipelines Job.
+	AIPlatformPipelineJob Type = "aiplatform.googleapis.com/PipelineJob"
+
+	// AlloyDBInstance is the AlloyDB instance monitored resource type.
+	//
+	// Monitored resource representing an AlloyDB instance.
+	AlloyDBInstance Type = "alloydb.googleapis.com/Instance"
+
+	// API is the Produced API monitored resource type.
+	//
+	// An API provided by the producer.
+	API Type = "api"
+
+	// APIGatewayGateway is the API Gateway monitored resource type.
+	//
+	// Fully managed API Gateway.
+	APIGatewayGateway Type = "apigateway.googleapis.com/Gateway"
+
+	// ApigeeEnvironment is the Apigee environment monitored resource type.
+	//
+	// Monitored resource for Apigee environment.
+	ApigeeEnvironment Type = "apigee.googleapis.com/Environment"
+
+	// AppScriptFunction is the Apps Script Function monitored resource type.
+	//
+	// An Apps Script function.
+	AppScriptFunction Type = "app_script_function"
+
+	// AssistantAction is the Google Assistant Action monitored resource type.
+	//
+	// An Action in a Google Assistant App.
+	AssistantAction Type = "assistant_action"
+
+	// AuditedResource is the Audited Resource monitored resource type.
+	//
+	// A Google Cloud resource that produces an audit log.
+	AuditedResource Type = "audited_resource"
+
+	// Autoscaler is the Autoscaler monitored resource type.
+	//
+	// An autoscaler for a single managed instance group.
+	Autoscaler Type = "autoscaler"
+
+	// AWSALBLoadBalancer is the Amazon ALB Load Balancer monitored resource type.
+	//
+	// A load balancer in Amazon ALB.
+	AWSALBLoadBalancer Type = "aws_alb_load_balancer"
+
+	// AWSCloudFrontDistribution is the Amazon CloudFront CDN monitored resource type.
+	//
+	// A CloudFront content distribution network.
+	AWSCloudFrontDistribution Type = "aws_cloudfront_distribution"
+
+	// AWSDynamoDBTable is the Amazon DynamoDB Table monitored resource type.
+	//
+	// A table in Amazon DynamoDB.
+	AWSDynamoDBTable Type = "aws_dynamodb_table"
+
+	// AWSEBSVolume is the Amazon EBS Volume monitored resource type.
+	//
+	// An Amazon EC2 Elastic Block Storage volume.
+	AWSEBSVolume Type = "aws_ebs_volume"
+
+	// AWSEC2Instance is the Amazon EC2 Instance monitored resource type.
+	//
+	// A VM instance in Amazon EC2.
+	AWSEC2Instance Type = "aws_ec2_instance"
+
+	// AWSElastiCacheCluster is the Amazon Elasticache Cluster monitored resource type.
+	//
+	// A cache cluster in Amazon Elasticache.
+	AWSElastiCacheCluster Type = "aws_elasticache_cluster"
+
+	// AWSELBLoadBalancer is the Amazon Elastic Load Balancer monitored resource type.
+	//
+	// A load balancer in Amazon Elastic Load Balancer.
+	AWSELBLoadBalancer Type = "aws_elb_load_balancer"
+
+	// AWSEMRCluster is the Amazon EMR Cluster monitored resource type.
+	//
+	// A cluster in Amazon Elastic MapReduce.
+	AWSEMRCluster Type = "aws_emr_cluster"
+
+	// AWSKinesisStream is the Amazon Kinesis Stream monitored resource type.
+	//
+	// A stream in Amazon Kinesis.
+	AWSKinesisStream Type = "aws_kinesis_stream"
+
+	// AWSLambdaFunction is the Amazon Lambda Function monitored resource type.
+	//
+	// A function in Amazon Lambda.
+	AWSLambdaFunction Type = "aws_lambda_function"
+
+	// AWSRDSDatabase is the Amazon RDS Database monitored resource type.
+	//
+	// A database in Amazon Relational Database Service.
+	AWSRDSDatabase Type = "aws_rds_database"
+
+	// AWSRedshiftCluster is the Amazon Redshift Cluster monitored resource type.
+	//
+	// A cluster in Amazon Redshift.
+	AWSRedshiftCluster Type = "aws_redshift_cluster"
+
+	// AWSS3Bucket is the Amazon S3 Bucket monitored resource type.
+	//
+	// A bucket in Amazon S3.
+	AWSS3Bucket Type = "aws_s3_bucket"
+
+	// AWSSES is the Amazon SES Region monitored resource type.
+	//
+	// An Amazon region with Amazon Simple Email Service enabled.
+	AWSSES Type = "aws_ses"
+
+	// AWSSNSTopic is the Amazon SNS Topic monitored resource type.
+	//
+	// A topic in Amazon SNS.
+	AWSSNSTopic Type = "aws_sns_topic"
+
+	// AWSSQSQueue is the Amazon SQS Queue monitored resource type.
+	//
+	// A queue in Amazon Simple Queue Service.
+	AWSSQSQueue Type = "aws_sqs_queue"
+
+	// BigQueryBIEngineModel is the BigQuery BI Engine Model monitored resource type.
+	//
+	// BigQuery BI Engine Model.
+	BigQueryBIEngineModel Type = "bigquery_biengine_model"
+
+	// BigQueryDataset is the BigQuery Dataset monitored resource type.
+	//
+	// A dataset in BigQuery.
+	BigQueryDataset Type = "bigquery_dataset"
+
+	// BigQueryDTSConfig is the BigQuery DTS Config monitored resource type.
+	//
+	// A BigQuery Data Transfer Service configuration.
+	BigQueryDTSConfig Type = "bigquery_dts_config"
+
+	// BigQueryDTSRun is the BigQuery DTS Run monitored resource type.
+	//
+	// A BigQuery Data Transfer Service Run.
+	BigQueryDTSRun Type = "bigquery_dts_run"
+
+	// BigQueryProject is the BigQuery Project monitored resource type.
+	//
+	// BigQuery Project.
+	BigQueryProject Type = "bigquery_project"
+
+	// BigQueryResource is the BigQuery monitored resource type.
+	//
+	// A BigQuery resource.
+	BigQueryResource Type = "bigquery_resource"
+
+	// BigQueryTable is the BigQuery Table monitored resource type.
+	//
+	// An individual BigQuery table.
+	BigQueryTable Type = "bigquery_table"
+
+	// BillingAccount is the Cloud Billing Account monitored resource type.
+	//
+	// A Cloud Billing Account.
+	BillingAccount Type = "billing_account"
+
+	// Build is the Cloud Build monitored resource type.
+	//
+	// A build in Cloud Build.
+	Build Type = "build"
+
+	// CertificateManagerProject is the Certificate Manager project monitored resource type.
+	//
+	// Certificate Manager project.
+	CertificateManagerProject Type = "certificatemanager.googleapis.com/Project"
+
+	// ClientAuthConfigBrand is the OAuth2 Brand monitored resource type.
+	//
+	// Consent screen data shown to users during three-legged OAuth2 flows.
+	ClientAuthConfigBrand Type = "client_auth_config_brand"
+
+	// ClientAuthConfigClient is the OAuth2 Client monitored resource type.
+	//
+	// A client used in OAuth2 flows.
+	ClientAuthConfigClient Type = "client_auth_config_client"
+
+	// CloudComposerEnvironment is the Cloud Composer Environment monitored resource type.
+	//
+	// A Composer environment runs the managed Apache Airflow service.
+	CloudComposerEnvironment Type = "cloud_composer_environment"
+
+	// CloudDataprocBatch is the Cloud Dataproc Batch monitored resource type.
+	//
+	// A Dataproc batch execution.
+	CloudDataprocBatch Type = "cloud_dataproc_batch"
+
+	// CloudDataprocCluster is the Cloud Dataproc Cluster monitored resource type.
+	//
+	// A Dataproc cluster with separate cluster name and id labels.
+	CloudDataprocCluster Type = "cloud_dataproc_cluster"
+
+	// CloudDataprocJob is the Cloud Dataproc Job monitored resource type.
+	//
+	// A Dataproc job execution.
+	CloudDataprocJob Type = "cloud_dataproc_job"
+
+	// CloudDebuggerResource is the Cloud Debugger monitored resource type.
+	//
+	// A Google Cloud Debugger resource.
+	CloudDebuggerResource Type = "cloud_debugger_resource"
+
+	// CloudFunction is the Cloud Function monitored resource type.
+	//
+	// A function in Google Cloud Functions.
+	CloudFunction Type = "cloud_function"
+
+	// CloudRunJob is the Cloud Run Job monitored resource type.
+	//
+	// A job in Cloud Run.
+	CloudRunJob Type = "cloud_run_job"
+
+	// CloudRunRevision is the Cloud Run Revision monitored resource type.
+	//
+	// A revision in Cloud Run.
+	CloudRunRevision Type = "cloud_run_revision"
+
+	// CloudSchedulerJob is the Cloud Scheduler Job monitored resource type.
+	//
+	// A Cloud Scheduler Job.
+	CloudSchedulerJob Type = "cloud_scheduler_job"
+
+	// CloudTasksQueue is the Cloud Tasks Queue monitored resource type.
+	//
+	// A queue in Cloud Tasks.
+	CloudTasksQueue Type = "cloud_tasks_queue"
+
+	// CloudDeployDeliveryPipeline is the Cloud Deploy Delivery Pipeline monitored resource type.
+	//
+	// A Cloud Deploy Delivery Pipeline.
+	CloudDeployDeliveryPipeline Type = "clouddeploy.googleapis.com/DeliveryPipeline"
+
+	// CloudIoTDevice is the Cloud IoT Device monitored resource type.
+	//
+	// A Device in Google Cloud IoT.
+	CloudIoTDevice Type = "cloudiot_device"
+
+	// CloudIoTDeviceRegistry is the Cloud IoT Registry monitored resource type.
+	//
+	// A Device Registry in Google Cloud IoT.
+	CloudIoTDeviceRegistry Type = "cloudiot_device_registry"
+
+	// CloudKMSCryptoKey is the Cloud KMS CryptoKey monitored resource type.
+	//
+	// Cryptographic key in the KMS.
+	CloudKMSCryptoKey Type = "cloudkms_cryptokey"
+
+	// CloudKMSCryptoKeyVersion is the Cloud KMS CryptoKeyVersion monitored resource type.
+	//
+	// Version of a cryptographic key.
+	CloudKMSCryptoKeyVersion Type = "cloudkms_cryptokeyversion"
+
+	// CloudKMSKeyRing is the Cloud KMS Key Ring monitored resource type.
+	//
+	// Collection of cryptographic keys.
+	CloudKMSKeyRing Type = "cloudkms_keyring"
+
+	// CloudMLModelVersion is the Cloud ML Model Version monitored resource type.
+	//
+	// A Google Cloud ML model version.
+	CloudMLModelVersion Type = "cloudml_model_version"
+
+	// CloudSQLDatabase is the Cloud SQL Database monitored resource type.
+	//
+	// A database hosted in Google Cloud SQL.
+	CloudSQLDatabase Type = "cloudsql_database"
+
+	// NetAppCloudVolume is the Monitored Resource for NetApp CVS monitored resource type.
+	//
+	// Monitored Resource for NetApp CVS.
+	NetAppCloudVolume Type = "cloudvolumesgcp-api.netapp.com/CloudVolume"
+
+	// ConsumedAPI is the Consumed API monitored resource type.
+	//
+	// An API used by customers.
+	ConsumedAPI Type = "consumed_api"
+
+	// Container is the GKE Container monitored resource type.
+	//
+	// A Google Kubernetes Engine (GKE) container instance.
+	Container Type = "container"
+
+	// CSRRepository is the Cloud Source Repository monitored resource type.
+	//
+	// A repository in Google Cloud Source Repositories.
+	CSRRepository Type = "csr_repository"
+
+	// DataflowStep is the Dataflow Step monitored resource type.
+	//
+	// A step in a Dataflow job.
+	DataflowStep Type = "dataflow_step"
+
+	// DataMigrationMigrationJob is the Database migration service migration job monitored resource type.
+	//
+	// Database migration service migration job.
+	DataMigrationMigrationJob Type = "datamigration.googleapis.com/MigrationJob"
+
+	// DataplexEnvironment is the Cloud Dataplex Environment monitored resource type.
+	//
+	// An Environment within a Cloud Dataplex Lake.
+	DataplexEnvironment Type = "dataplex.googleapis.com/Environment"
+
+	// DataplexLake is the Cloud Dataplex Lake monitored resource type.
+	//
+	// A Cloud Dataplex Lake.
+	DataplexLake Type = "dataplex.googleapis.com/Lake"
+
+	// DataplexTask is the Cloud Dataplex Task monitored resource type.
+	//
+	// A Task within a Cloud Dataplex Lake.
+	DataplexTask Type = "dataplex.googleapis.com/Task"
+
+	// DataplexZone is the Cloud Dataplex Zone monitored resource type.
+	//
+	// A Zone within a Cloud Dataplex Lake.
+	DataplexZone Type = "dataplex.googleapis.com/Zone"
+
+	// DataprocCluster is the Dataproc Cluster monitored resource type.
+	//
+	// A Dataproc cluster.
+	DataprocCluster Type = "dataproc_cluster"
+
+	// DatastoreDatabase is the Cloud Datastore Database monitored resource type.
+	//
+	// A Cloud Datastore database.
+	DatastoreDatabase Type = "datastore_database"
+
+	// DatastoreIndex is the Cloud Datastore Index monitored resource type.
+	//
+	// A Cloud Datastore index.
+	DatastoreIndex Type = "datastore_index"
+
+	// DatastreamStream is the Datastream Stream monitored resource type.
+	//
+	// A Datastream stream.
+	DatastreamStream Type = "datastream.googleapis.com/Stream"
+
+	// Deployment is the Deployment monitored resource type.
+	//
+	// A Deployment Manager deployment.
+	Deployment Type = "deployment"
+
+	// DeploymentManagerManifest is the Deployment Manager Manifest monitored resource type.
+	//
+	// A Deployment Manager manifest which is used to specify the contents of a deployment.
+	DeploymentManagerManifest Type = "deployment_manager_manifest"
+
+	// DeploymentManagerOperation is the Deployment Manager Operation monitored resource type.
+	//
+	// A Deployment Manager operation.
+	DeploymentManagerOperation Type = "deployment_manager_operation"
+
+	// DeploymentManagerResource is the Deployment Manager Resource monitored resource type.
+	//
+	// Deployment Manager's record of Google Cloud Platform resources in a Deployment, such as a VM or a bucket.
+	DeploymentManagerResource Type = "deployment_manager_resource"
+
+	// DeploymentManagerType is the Deployment Manager Type monitored resource type.
+	//
+	// A Deployment Manager type.
+	DeploymentManagerType Type = "deployment_manager_type"
+
+	// DNSManagedZone is the Managed DNS Zone monitored resource type.
+	//
+	// A ManagedZone in the Google Cloud DNS service.
+	DNSManagedZone Type = "dns_managed_zone"
+
+	// DNSPolicy is the Cloud DNS Policy monitored resource type.
+	//
+	// A Policy in the Google Cloud DNS service.
+	DNSPolicy Type = "dns_policy"
+
+	// DNSQuery is the Cloud DNS Query monitored resource type.
+	//
+	// A DNS query to a private DNS handled by the Google Cloud DNS service.
+	DNSQuery Type = "dns_query"
+
+	// FirebaseDomain is the Firebase Hosting Site Domain monitored resource type.
+	//
+	// A domain from which a Firebase Hosting site is serving traffic.
+	FirebaseDomain Type = "firebase_domain"
+
+	// FirebaseNamespace is the Firebase Realtime Database monitored resource type.
+	//
+	// A Firebase Realtime Database.
+	FirebaseNamespace Type = "firebase_namespace"
+
+	// FleetEngineFleet is the Fleet Engine On Demand Rides and Deliveries monitored resource type.
+	//
+	// A top-level resource for Fleet Engine On Demand Rides and Deliveries metrics and logs.
+	FleetEngineFleet Type = "fleetengine.googleapis.com/Fleet"
+
+	// Folder is the Google Folder monitored resource type.
+	//
+	// A Google Cloud Platform folder.
+	Folder Type = "folder"
+
+	// GAEApp is the GAE Application monitored resource type.
+	//
+	// An application running in Google App Engine (GAE).
+	GAEApp Type = "gae_app"
+
+	// GatewayScope is the Gateway Scope monitored resource type.
+	//
+	// GatewayScope represents a set of Gateways with the same merged configs.
+	GatewayScope Type = "gateway_scope"
+
+	// GCEAutoscaler is the GCE Autoscaler monitored resource type.
+	//
+	// A Google Compute Engine (GCE) autoscaler.
+	GCEAutoscaler Type = "gce_autoscaler"
+
+	// GCEBackendBucket is the GCE Backend Bucket monitored resource type.
+	//
+	// A Google Compute Engine (GCE) backend bucket.
+	GCEBackendBucket Type = "gce_backend_bucket"
+
+	// GCEBackendService is the Compute Engine Backend Service monitored resource type.
+	//
+	// A Compute Engine backend service.
+	GCEBackendService Type = "gce_backend_service"
+
+	// GCEClientSSLPolicy is the GCE Client SSL Policy monitored resource type.
+	//
+	// A Google Compute Engine (GCE) client SSL policy.
+	GCEClientSSLPolicy Type = "gce_client_ssl_policy"
+
+	// GCECommitment is the GCE Committed Use Discount monitored resource type.
+	//
+	// A Google Compute Engine (GCE) committed use discount.
+	GCECommitment Type = "gce_commitment"
+
+	// GCEDisk is the Disk monitored resource type.
+	//
+	// A disk belonging to a Compute Engine instance.
+	GCEDisk Type = "gce_disk"
+
+	// GCEFirewallRule is the GCE Firewall Rule monitored resource type.
+	//
+	// A Google Compute Engine (GCE) firewall rule.
+	GCEFirewallRule Type = "gce_firewall_rule"
+
+	// GCEForwardingRule is the GCE Forwarding Rule monitored resource type.
+	//
+	// A Google Compute Engine (GCE) Forwarding Rule.
+	GCEForwardingRule Type = "gce_forwarding_rule"
+
+	// GCEHealthCheck is the GCE Health Check monitored resource type.
+	//
+	// A Google Compute Engine (GCE) health check.
+	GCEHealthCheck Type = "gce_health_check"
+
+	// GCEImage is the GCE Image monitored resource type.
+	//
+	// A Google Compute Engine (GCE) image resource.
+	GCEImage Type = "gce_image"
+
+	// GCEInstance is the VM Instance monitored resource type.
+	//
+	// A virtual machine instance hosted in Compute Engine.
+	GCEInstance Type = "gce_instance"
+
+	// GCEInstanceGroup is the GCE Instance Group monitored resource type.
+	//
+	// A Google Compute Engine (GCE) instance group resource.
+	GCEInstanceGroup Type = "gce_instance_group"
+
+	// GCEInstanceGroupManager is the GCE Instance Group Manager monitored resource type.
+	//
+	// A Google Compute Engine (GCE) instance group manager resource.
+	GCEInstanceGroupManager Type = "gce_instance_group_manager"
+
+	// GCEInstanceTemplate is the GCE Instance Template monitored resource type.
+	//
+	// A Google Compute Engine (GCE) instance template resource.
+	GCEInstanceTemplate Type = "gce_instance_template"
+
+	// GCELicense is the GCE License monitored resource type.
+	//
+	// A Google Compute Engine (GCE) license.
+	GCELicense Type = "gce_license"
+
+	// GCENetwork is the GCE Network monitored resource type.
+	//
+	// A Google Compute Engine (GCE) network.
+	GCENetwork Type = "gce_network"
+
+	// GCENetworkEndpointGroup is the Network Endpoint Group monitored resource type.
+	//
+	// A Compute Engine network endpoint group resource.
+	GCENetworkEndpointGroup Type = "gce_network_endpoint_group"
+
+	// GCENetworkRegion is the Network Region monitored resource type.
+	//
+	// A region of a Compute Engine network.
+	GCENetworkRegion Type = "gce_network_region"
+
+	// GCENodeGroup is the Node Group monitored resource type.
+	//
+	// A Compute Engine node group.
+	GCENodeGroup Type = "gce_node_group"
+
+	// GCENodeTemplate is the Node Template monitored resource type.
+	//
+	// A Compute Engine node template.
+	GCENodeTemplate Type = "gce_node_template"
+
+	// GCEOperation is the GCE Operation monitored resource type.
+	//
+	// A Google Compute Engine (GCE) operation resource.
+	GCEOperation Type = "gce_operation"
+
+	// GCEPacketMirroring is the GCE Packet Mirroring monitored resource type.
+	//
+	// A Google Compute Engine (GCE) packet mirroring.
+	GCEPacketMirroring Type = "gce_packet_mirroring"
+
+	// GCEProject is the GCE Project monitored resource type.
+	//
+	// A Google Compute Engine (GCE) project resource.
+	GCEProject Type = "gce_project"
+
+	// GCEReservedAddress is the GCE Reserved Address monitored resource type.
+	//
+	// A Google Compute Engine reserved address.
+	GCEReservedAddress Type = "gce_reserved_address"
+
+	// GCEResourcePolicy is the Resource Policy monitored resource type.
+	//
+	// A Compute Engine resource policy.
+	GCEResourcePolicy Type = "gce_resource_policy"
+
+	// GCERoute is the GCE Route monitored resource type.
+	//
+	// A Google Compute Engine (GCE) route.
+	GCERoute Type = "gce_route"
+
+	// GCERouter is the GCE Router monitored resource type.
+	//
+	// A Google Compute Engine (GCE) router.
+	GCERouter Type = "gce_router"
+
+	// GCESnapshot is the GCE Snapshot monitored resource type.
+	//
+	// A Google Compute Engine (GCE) snapshot.
+	GCESnapshot Type = "gce_snapshot"
+
+	// GCESSLCertificate is the GCE SSL Certificate monitored resource type.
+	//
+	// A Google Compute Engine (GCE) SSL certificate.
+	GCESSLCertificate Type = "gce_ssl_certificate"
+
+	// GCESubnetwork is the Subnetwork monitored resource type.
+	//
+	// A Compute Engine subnetwork.
+	GCESubnetwork Type = "gce_subnetwork"
+
+	// GCETargetHTTPInstance is the GCE Target HTTP Instance monitored resource type.
+	//
+	// A Google Compute Engine (GCE) target http instance.
+	GCETargetHTTPInstance Type = "gce_target_http_instance"
+
+	// GCETargetHTTPProxy is the GCE Target HTTP Proxy monitored resource type.
+	//
+	// A Google Compute Engine (GCE) target http proxy.
+	GCETargetHTTPProxy Type = "gce_target_http_proxy"
+
+	// GCETargetHTTPSProxy is the GCE Target HTTPS Proxy monitored resource type.
+	//
+	// A Google Compute Engine (GCE) target https proxy.
+	GCETargetHTTPSProxy Type = "gce_target_https_proxy"
+
+	// GCETargetPool is the GCE Target Pool monitored resource type.
+	//
+	// A Google Compute Engine (GCE) target pool.
+	GCETargetPool Type = "gce_target_pool"
+
+	// GCETargetSSLProxy is the GCE Target SSL Proxy monitored resource type.
+	//
+	// A Google Compute Engine (GCE) target SSL proxy.
+	GCETargetSSLProxy Type = "gce_target_ssl_proxy"
+
+	// GCEURLMap is the GCE URL Map monitored resource type.
+	//
+	// A Google Compute Engine (GCE) URL map.
+	GCEURLMap Type = "gce_url_map"
+
+	// GCSBucket is the GCS Bucket monitored resource type.
+	//
+	// A Google Cloud Storage (GCS) bucket.
+	GCSBucket Type = "gcs_bucket"
+
+	// GenericNode is the Generic Node monitored resource type.
+	//
+	// A generic node identifies a machine or other computational resource for which no more specific resource type is applicable. The label values must uniquely identify the node.
+	GenericNode Type = "generic_node"
+
+	// GenericTask is the Generic Task monitored resource type.
+	//
+	// A generic task identifies an application process for which no more specific resource is applicable, such as a process scheduled by a custom orchestration system. The label values must uniquely identify the task.
+	GenericTask Type = "generic_task"
+
+	// GenomicsDataset is the Genomics Dataset monitored resource type.
+	//
+	// A dataset in the Google Genomics service.
+	GenomicsDataset Type = "genomics_dataset"
+
+	// GenomicsOperation is the Genomics Operation monitored resource type.
+	//
+	// A long running operation in the Google Genomics service.
+	GenomicsOperation Type = "genomics_operation"
+
+	// GKECluster is the GKE Cluster Operations monitored resource type.
+	//
+	// A Google Kubernetes Engine (GKE) Cluster. It contains events and audit logs about cluster operations.
+	GKECluster Type = "gke_cluster"
+
+	// GKENodePool is the GKE Node Pool Operations monitored resource type.
+	//
+	// A Google Kubernetes Engine (GKE) Node Pool. It contains audit logs about Node Pool operations.
+	GKENodePool Type = "gke_nodepool"
+
+	// GKEBackupBackupPlan is the GKE Backup Plan monitored resource type.
+	//
+	// A backup plan provides configuration, location, and management functions for a sequence of backups.
+	GKEBackupBackupPlan Type = "gkebackup.googleapis.com/BackupPlan"
+
+	// GKEBackupRestorePlan is the GKE Restore Plan monitored resource type.
+	//
+	// A restore plan defines the configuration of a series of restore operations to be performed against backups which belong to the specified backup plan.
+	GKEBackupRestorePlan Type = "gkebackup.googleapis.com/RestorePlan"
+
+	// Global is the Global monitored resource type.
+	//
+	// A resource type used to indicate that a log is not associated with any specific resource.
+	Global Type = "global"
+
+	// HealthcareAnnotationStore is the Healthcare Annotation Store monitored resource type.
+	//
+	// A Cloud Healthcare Annotation store containing Annotation records.
+	HealthcareAnnotationStore Type = "healthcare_annotation_store"
+
+	// HealthcareConsentStore is the Healthcare Consent Store monitored resource type.
+	//
+	// A Cloud Healthcare Consent store containing consent records.
+	HealthcareConsentStore Type = "healthcare_consent_store"
+
+	// HealthcareDataset is the Healthcare Dataset monitored resource type.
+	//
+	// A Cloud Healthcare dataset.
+	HealthcareDataset Type = "healthcare_dataset"
+
+	// HealthcareDICOMStore is the Healthcare DICOM Store monitored resource type.
+	//
+	// A Cloud Healthcare DICOM store containing DICOM instances.
+	HealthcareDICOMStore Type = "healthcare_dicom_store"
+
+	// HealthcareFHIRStore is the Healthcare FHIR Store monitored resource type.
+	//
+	// A Cloud Healthcare FHIR store containing FHIR resources representing electronic medical information.
+	HealthcareFHIRStore Type = "healthcare_fhir_store"
+
+	// HealthcareHL7v2Store is the Healthcare HL7v2 Store monitored resource type.
+	//
+	// A Cloud Healthcare HL7v2 store containing clinical messages.
+	HealthcareHL7v2Store Type = "healthcare_hl7v2_store"
+
+	// HTTPExternalRegionalLBRule is the HTTP/S External Regional Load Balancing Rule monitored resource type.
+	//
+	// A resource descriptor for HTTP/S External Regional load balancing behavior.
+	HTTPExternalRegionalLBRule Type = "http_external_regional_lb_rule"
+
+	// HTTPLoadBalancer is the Cloud HTTP Load Balancer monitored resource type.
+	//
+	// A Cloud HTTP Load Balancer Instance.
+	HTTPLoadBalancer Type = "http_load_balancer"
+
+	// IAMRole is the IAM Role monitored resource type.
+	//
+	// An IAM role.
+	IAMRole Type = "iam_role"
+
+	// IdentityToolkitProject is the Project monitored resource type.
+	//
+	// An Identity Toolkit project.
+	IdentityToolkitProject Type = "identitytoolkit_project"
+
+	// IdentityToolkitTenant is the Identity Toolkit Tenant monitored resource type.
+	//
+	// An Identity Toolkit tenant.
+	IdentityToolkitTenant Type = "identitytoolkit_tenant"
+
+	// IDSEndpoint is the IDS Endpoint monitored resource type.
+	//
+	// A Cloud IDS Endpoint.
+	IDSEndpoint Type = "ids.googleapis.com/Endpoint"
+
+	// IstioControlPlane is the Istio Control Plane monitored resource type.
+	//
+	// An Istio Control Plane is an instance of a service that provides xDS and related functionality to a set of managed Istio proxies.
+	IstioControlPlane Type = "istio_control_plane"
+
+	// K8sCluster is the Kubernetes Cluster monitored resource type.
+	//
+	// A Kubernetes cluster. It contains Kubernetes audit logs from the cluster.
+	K8sCluster Type = "k8s_cluster"
+
+	// K8sContainer is the Kubernetes Container monitored resource type.
+	//
+	// A Kubernetes container instance.
+	K8sContainer Type = "k8s_container"
+
+	// K8sControlPlaneComponent is the Kubernetes Control Plane Component monitored resource type.
+	//
+	// A Kubernetes Control Plane component.
+	K8sControlPlaneComponent Type = "k8s_control_plane_component"
+
+	// K8sNode is the Kubernetes Node monitored resource type.
+	//
+	// A Kubernetes node instance.
+	K8sNode Type = "k8s_node"
+
+	// K8sPod is the Kubernetes Pod monitored resource type.
+	//
+	// A Kubernetes pod instance.
+	K8sPod Type = "k8s_pod"
+
+	// K8sService is the Kubernetes Service monitored resource type.
+	//
+	// A Kubernetes Service instance.
+	K8sService Type = "k8s_service"
+
+	// L4ProxyRule is the Layer 4 Proxying Rule for TCP/UDP/SSL Traffic monitored resource type.
+	//
+	// A resource descriptor for TCP/SSL/UDP Internal Regional load balancing behavior.
+	L4ProxyRule Type = "l4_proxy_rule"
+
+	// LiveStreamChannel is the Live Stream API Channel monitored resource type.
+	//
+	// A Live Stream API Channel.
+	LiveStreamChannel Type = "livestream.googleapis.com/Channel"
+
+	// LoadBalancingExternalNetworkLoadBalancerRule is the Google Cloud External Network Load Balancer Rule monitored resource type.
+	//
+	// A set of definitions for multi protocol network load balancing behavior.
+	LoadBalancingExternalNetworkLoadBalancerRule Type = "loadbalancing.googleapis.com/ExternalNetworkLoadBalancerRule"
+
+	// LoadBalancingInternalNetworkLoadBalancerRule is the Google Cloud Internal Network Load Balancer Rule monitored resource type.
+	//
+	// A set of definitions for multi protocol internal load balancing behavior.
+	LoadBalancingInternalNetworkLoadBalancerRule Type = "loadbalancing.googleapis.com/InternalNetworkLoadBalancerRule"
+
+	// LoggingBucket is the Logging Bucket monitored resource type.
+	//
+	// An export bucket in Cloud Logging.
+	LoggingBucket Type = "logging_bucket"
+
+	// LoggingExclusion is the Log Exclusion monitored resource type.
+	//
+	// An exclusion in Cloud Logging.
+	LoggingExclusion Type = "logging_exclusion"
+
+	// LoggingLog is the Log stream monitored resource type.
+	//
+	// A Google Cloud Logging log.
+	LoggingLog Type = "logging_log"
+
+	// LoggingSink is the Logging export sink monitored resource type.
+	//
+	// An export sink in Cloud Logging.
+	LoggingSink Type = "logging_sink"
+
+	// ManagedService is the Managed Service monitored resource type.
+	//
+	// A service managed by Google Service Management.
+	ManagedService Type = "managed_service"
+
+	// Mesh is the Mesh monitored resource type.
+	//
+	// A mesh serves as the "key" to deliver configuration to data plane proxy instances.
+	Mesh Type = "mesh"
+
+	// MetastoreService is the Dataproc Metastore Service monitored resource type.
+	//
+	// A Dataproc Metastore Service.
+	MetastoreService Type = "metastore.googleapis.com/Service"
+
+	// Metric is the Metric Type monitored resource type.
+	//
+	// A Stackdriver Monitoring metric type.
+	Metric Type = "metric"
+
+	// MLJob is the Cloud ML Job monitored resource type.
+	//
+	// A Cloud Machine Learning job.
+	MLJob Type = "ml_job"
+
+	// NATGateway is the Cloud NAT Gateway monitored resource type.
+	//
+	// A Cloud NAT Gateway.
+	NATGateway Type = "nat_gateway"
+
+	// NetworkSecurityPolicy is the Network Security Policy monitored resource type.
+	//
+	// A network security policy.
+	NetworkSecurityPolicy Type = "network_security_policy"
+
+	// NetworkingLocation is the GCP Location monitored resource type.
+	//
+	// A GCP location: a specific zone or region, or "global".
+	NetworkingLocation Type = "networking.googleapis.com/Location"
+
+	// Organization is the Google Organization monitored resource type.
+	//
+	// A Google Cloud Platform organization.
+	Organization Type = "organization"
+
+	// Project is the Google Project monitored resource type.
+	//
+	// A Google project.
+	Project Type = "project"
+
+	// PubSubSnapshot is the Cloud Pub/Sub Snapshot monitored resource type.
+	//
+	// A snapshot in Google Cloud Pub/Sub.
+	PubSubSnapshot Type = "pubsub_snapshot"
+
+	// PubSubSubscription is the Cloud Pub/Sub Subscription monitored resource type.
+	//
+	// A subscription in Google Cloud Pub/Sub.
+	PubSubSubscription Type = "pubsub_subscription"
+
+	// PubSubTopic is the Cloud Pub/Sub Topic monitored resource type.
+	//
+	// A topic in Google Cloud Pub/Sub.
+	PubSubTopic Type = "pubsub_topic"
+
+	// RecaptchaEnterpriseKey is the reCAPTCHA Key monitored resource type.
+	//
+	// Monitoring resource for reCAPTCHA Key.
+	RecaptchaEnterpriseKey Type = "recaptchaenterprise.googleapis.com/Key"
+
+	// Recommender is the Recommender monitored resource type.
+	//
+	// A Recommender represents a grouping of similar recommendations.
+	Recommender Type = "recommender"
+
+	// RecommenderInsightType is the InsightType monitored resource type.
+	//
+	// An InsightType represents a grouping of similar insights.
+	RecommenderInsightType Type = "recommender_insight_type"
+
+	// RedisInstance is the Cloud Memorystore Redis Instance monitored resource type.
+	//
+	// A Redis instance hosted on Google Cloud Memorystore.
+	RedisInstance Type = "redis_instance"
+
+	// ReportedErrors is the Reported Errors monitored resource type.
+	//
+	// Error data and metadata managed by Stackdriver Error Reporting
+	ReportedErrors Type = "reported_errors"
+
+	// SecretManagerSecret is the Secret Manager Secret monitored resource type.
+	//
+	// A logical secret whose value and versions can be accessed.
+	SecretManagerSecret Type = "secretmanager.googleapis.com/Secret"
+
+	// ServiceAccount is the Service Account monitored resource type.
+	//
+	// A service account.
+	ServiceAccount Type = "service_account"
+
+	// ServiceConfig is the Service Configuration monitored resource type.
+	//
+	// A specific service configuration.
+	ServiceConfig Type = "service_config"
+
+	// ServiceRollout is the Service Rollout monitored resource type.
+	//
+	// A resource type used to describe how a service configuration is deployed to backend systems.
+	ServiceRollout Type = "service_rollout"
+
+	// ServiceDirectoryNamespace is the Service Directory Namespace monitored resource type.
+	//
+	// A namespace in the Service Directory service.
+	ServiceDirectoryNamespace Type = "servicedirectory_namespace"
+
+	// ServiceUsageService is the Service monitored resource type.
+	//
+	// A service activated or deactivated by a consumer project.
+	ServiceUsageService Type = "serviceusage_service"
+
+	// ServiceUserService is the Service monitored resource type.
+	//
+	// A service activated or deactivated by a consumer project.
+	ServiceUserService Type = "serviceuser_service"
+
+	// SpannerInstance is the Cloud Spanner Instance monitored resource type.
+	//
+	// A Cloud Spanner instance.
+	SpannerInstance Type = "spanner_instance"
+
+	// StorageTransferJob is the Cloud Storage Transfer Job monitored resource type.
+	//
+	// A Google Cloud storage transfer job.
+	StorageTransferJob Type = "storage_transfer_job"
+
+	// TCPSSLProxyRule is the Google Cloud TCP/SSL Proxy Rule monitored resource type.
+	//
+	// A set of definitions for TCP/SSL proxy behavior.
+	TCPSSLProxyRule Type = "tcp_ssl_proxy_rule"
+
+	// TestServiceMatrix is the Test Matrix monitored resource type.
+	//
+	// A Test Matrix in the Google Cloud Test Lab service.
+	TestServiceMatrix Type = "testservice_matrix"
+
+	// ThreatDetector is the Threat Detector monitored resource type.
+	//
+	// A detector in the Threat Detection service.
+	ThreatDetector Type = "threat_detector"
+
+	// UptimeURL is the Uptime Check URL monitored resource type.
+	//
+	// An Uptime Monitoring check against a custom URL.
+	UptimeURL Type = "uptime_url"
+
+	// VMMigrationMigratingVM is the Migrate to Virtual Machines Migrating VM monitored resource type.
+	//
+	// A Migrate to Virtual Machines Migrating VM.
+	VMMigrationMigratingVM Type = "vmmigration.googleapis.com/MigratingVM"
+
+	// VMMigrationSource is the Migrate to Virtual Machines Source monitored resource type.
+	//
+	// A Migrate to Virtual Machines Source.
+	VMMigrationSource Type = "vmmigration.googleapis.com/Source"
+
+	// VPCAccessConnector is the VPC Access Connector monitored resource type.
+	//
+	// A connector that can communicate with devices within a VPC.
+	VPCAccessConnector Type = "vpc_access_connector"
+
+	// VPNGateway is the Cloud VPN Gateway monitored resource type.
+	//
+	// A Cloud VPN gateway.
+	VPNGateway Type = "vpn_gateway"
+
+	// VPNTunnel is the Cloud VPN Tunnel monitored resource type.
+	//
+	// A Cloud VPN tunnel.
+	VPNTunnel Type = "vpn_tunnel"
+
+	// WorkflowsWorkflow is the Workflow monitored resource type.
+	//
+	// A Workflows specification of steps to execute.
+	WorkflowsWorkflow Type = "workflows.googleapis.com/Workflow"
+)
+
+// descriptors is the map of the monitored resource types to their descriptors.
+var descriptors = map[Type]*Descriptor{
+	AIPlatformEndpoint: {
+		Type:        AIPlatformEndpoint,
+		DisplayName: "Vertex AI Endpoint",
+		Description: "A Vertex AI API Endpoint where Models are deployed into it.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP Project owning the Endpoint."},
+			{Key: "location", Description: "The region in which the service is running."},
+			{Key: "endpoint_id", Description: "The ID of the Endpoint."},
+		},
+	},
+	AIPlatformFeaturestore: {
+		Type:        AIPlatformFeaturestore,
+		DisplayName: "Vertex AI Feature Store",
+		Description: "A Vertex AI Feature Store.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP Project owning the Featurestore."},
+			{Key: "location", Description: "The region in which the service is running."},
+			{Key: "featurestore_id", Description: "The ID of the Featurestore."},
+		},
+	},
+	AIPlatformIndexEndpoint: {
+		Type:        AIPlatformIndexEndpoint,
+		DisplayName: "Matching Engine Index Endpoint",
+		Description: "An Endpoint to which Matching Engine Indexes are deployed.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP Project owning the Index."},
+			{Key: "location", Description: "The region in which the service is running."},
+			{Key: "index_endpoint_id", Description: "The ID of the index endpoint."},
+		},
+	},
+	AIPlatformPipelineJob: {
+		Type:        AIPlatformPipelineJob,
+		DisplayName: "Vertex Pipelines Job",
+		Description: "A Vertex Pipelines Job.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The region in which the service is running."},
+			{Key: "pipeline_job_id", Description: "The ID of the PipelineJob."},
+		},
+	},
+	AlloyDBInstance: {
+		Type:        AlloyDBInstance,
+		DisplayName: "AlloyDB instance",
+		Description: "Monitored resource representing an AlloyDB instance.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP project associated with this resource."},
+			{Key: "location", Description: "The Google Cloud region in which the AlloyDB instance is running."},
+			{Key: "cluster_id", Description: "AlloyDB cluster identifier."},
+			{Key: "instance_id", Description: "AlloyDB instance identifier."},
+		},
+	},
+	API: {
+		Type:        API,
+		DisplayName: "Produced API",
+		Description: "An API provided by the producer.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "service", Description: "The API service name, such as \"cloudsql.googleapis.com\"."},
+			{Key: "method", Description: "The API method, such as \"disks.list\"."},
+			{Key: "version", Description: "The API version, such as \"v1\"."},
+			{Key: "location", Description: "The service specific notion of location. This can be the name of a zone, region, or \"global\"."},
+		},
+	},
+	APIGatewayGateway: {
+		Type:        APIGatewayGateway,
+		DisplayName: "API Gateway",
+		Description: "Fully managed API Gateway.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP Project owning the Gateway."},
+			{Key: "location", Description: "The region in which the Gateway is running."},
+			{Key: "gateway_id", Description: "The ID of the Gateway."},
+		},
+	},
+	ApigeeEnvironment: {
+		Type:        ApigeeEnvironment,
+		DisplayName: "Apigee environment",
+		Description: "Monitored resource for Apigee environment.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The GCP project ID that writes to this monitored resource."},
+			{Key: "org", Description: "An organization is a container for all the objects in an Apigee account."},
+			{Key: "env", Description: "An environment is a runtime execution context for the proxies in an organization."},
+			{Key: "location", Description: "Location where the Apigee infrastructure is provisioned."},
+		},
+	},
+	AppScriptFunction: {
+		Type:        AppScriptFunction,
+		DisplayName: "Apps Script Function",
+		Description: "An Apps Script function.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "invocation_type", Description: "The invocation type."},
+			{Key: "function_name", Description: "The function name."},
+		},
+	},
+	AssistantAction: {
+		Type:        AssistantAction,
+		DisplayName: "Google Assistant Action",
+		Description: "An Action in a Google Assistant App.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "version_id", Description: "Stringified version ID of the assistant agent."},
+			{Key: "action_id", Description: "Action ID of the assistant agent."},
+		},
+	},
+	AuditedResource: {
+		Type:        AuditedResource,
+		DisplayName: "Audited Resource",
+		Description: "A Google Cloud resource that produces an audit log.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "service", Description: "The name of the API service generating the audit log."},
+			{Key: "method", Description: "The name of the API method generating the audit log."},
+		},
+	},
+	Autoscaler: {
+		Type:        Autoscaler,
+		DisplayName: "Autoscaler",
+		Description: "An autoscaler for a single managed instance group.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The zone or region for the autoscaler."},
+			{Key: "autoscaler_id", Description: "The identifier for the autoscaler."},
+			{Key: "autoscaler_name", Description: "The name of the autoscaler."},
+			{Key: "instance_group_manager_id", Description: "The identifier for the managed instance group scaled by the given autoscaler."},
+			{Key: "instance_group_manager_name", Description: "The name of the managed instance group scaled by the givenautoscaler."},
+		},
+	},
+	AWSALBLoadBalancer: {
+		Type:        AWSALBLoadBalancer,
+		DisplayName: "Amazon ALB Load Balancer",
+		Description: "A load balancer in Amazon ALB.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "name", Description: "The name of the load balancer."},
+			{Key: "region", Description: "The AWS region for the load balancer. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the load balancer."},
+		},
+	},
+	AWSCloudFrontDistribution: {
+		Type:        AWSCloudFrontDistribution,
+		DisplayName: "Amazon CloudFront CDN",
+		Description: "A CloudFront content distribution network.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "distribution_id", Description: "The CloudFront distribution identifier assigned by AWS."},
+			{Key: "region", Description: "The AWS region for the CloudFront distribution. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the CDN."},
+		},
+	},
+	AWSDynamoDBTable: {
+		Type:        AWSDynamoDBTable,
+		DisplayName: "Amazon DynamoDB Table",
+		Description: "A table in Amazon DynamoDB.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "table", Description: "The table name."},
+			{Key: "region", Description: "The AWS region for the table. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the table."},
+		},
+	},
+	AWSEBSVolume: {
+		Type:        AWSEBSVolume,
+		DisplayName: "Amazon EBS Volume",
+		Description: "An Amazon EC2 Elastic Block Storage volume.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "volume_id", Description: "The EBS volume identifier assigned by AWS."},
+			{Key: "region", Description: "The AWS region for the volume. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the volume."},
+		},
+	},
+	AWSEC2Instance: {
+		Type:        AWSEC2Instance,
+		DisplayName: "Amazon EC2 Instance",
+		Description: "A VM instance in Amazon EC2.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "instance_id", Description: "The VM instance identifier assigned by AWS."},
+			{Key: "aws_account", Description: "The AWS account number under which the VM is running."},
+			{Key: "region", Description: "The AWS region in which the VM is running. Supported AWS region values are listed by service at http://docs.aws.amazon.com/general/latest/gr/rande.html. The value supplied for this label must be prefixed with 'aws:' (for example, 'aws:us-east-1' is a valid value while 'us-east-1' is not).", Format: AWSRegionFormat},
+		},
+	},
+	AWSElastiCacheCluster: {
+		Type:        AWSElastiCacheCluster,
+		DisplayName: "Amazon Elasticache Cluster",
+		Description: "A cache cluster in Amazon Elasticache.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "cluster_id", Description: "The cluster identifier."},
+			{Key: "region", Description: "The AWS region for the cluster. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the cluster."},
+		},
+	},
+	AWSELBLoadBalancer: {
+		Type:        AWSELBLoadBalancer,
+		DisplayName: "Amazon Elastic Load Balancer",
+		Description: "A load balancer in Amazon Elastic Load Balancer.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "region", Description: "The AWS region for the load balancer. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "name", Description: "The name of the load balancer."},
+			{Key: "aws_account", Description: "The AWS account number for the load balancer."},
+		},
+	},
+	AWSEMRCluster: {
+		Type:        AWSEMRCluster,
+		DisplayName: "Amazon EMR Cluster",
+		Description: "A cluster in Amazon Elastic MapReduce.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "cluster_id", Description: "The cluster identifier."},
+			{Key: "region", Description: "The AWS region for the cluster. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the cluster."},
+		},
+	},
+	AWSKinesisStream: {
+		Type:        AWSKinesisStream,
+		DisplayName: "Amazon Kinesis Stream",
+		Description: "A stream in Amazon Kinesis.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "stream_name", Description: "The stream name."},
+			{Key: "region", Description: "The AWS region for the stream. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the stream."},
+		},
+	},
+	AWSLambdaFunction: {
+		Type:        AWSLambdaFunction,
+		DisplayName: "Amazon Lambda Function",
+		Description: "A function in Amazon Lambda.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "function_name", Description: "The function name."},
+			{Key: "region", Description: "The AWS region for the function. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the function."},
+		},
+	},
+	AWSRDSDatabase: {
+		Type:        AWSRDSDatabase,
+		DisplayName: "Amazon RDS Database",
+		Description: "A database in Amazon Relational Database Service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "name", Description: "The database name."},
+			{Key: "region", Description: "The AWS region for the database. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the database."},
+		},
+	},
+	AWSRedshiftCluster: {
+		Type:        AWSRedshiftCluster,
+		DisplayName: "Amazon Redshift Cluster",
+		Description: "A cluster in Amazon Redshift.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "cluster_identifier", Description: "The cluster name."},
+			{Key: "region", Description: "The AWS region for the cluster. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the cluster."},
+		},
+	},
+	AWSS3Bucket: {
+		Type:        AWSS3Bucket,
+		DisplayName: "Amazon S3 Bucket",
+		Description: "A bucket in Amazon S3.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "bucket_name", Description: "The bucket name."},
+			{Key: "region", Description: "The AWS region for the bucket. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the bucket."},
+		},
+	},
+	AWSSES: {
+		Type:        AWSSES,
+		DisplayName: "Amazon SES Region",
+		Description: "An Amazon region with Amazon Simple Email Service enabled.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "region", Description: "The AWS region. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the SES region."},
+		},
+	},
+	AWSSNSTopic: {
+		Type:        AWSSNSTopic,
+		DisplayName: "Amazon SNS Topic",
+		Description: "A topic in Amazon SNS.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "topic", Description: "The topic name."},
+			{Key: "region", Description: "The AWS region for the topic. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the topic."},
+		},
+	},
+	AWSSQSQueue: {
+		Type:        AWSSQSQueue,
+		DisplayName: "Amazon SQS Queue",
+		Description: "A queue in Amazon Simple Queue Service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as \"my-project\"."},
+			{Key: "queue", Description: "The queue name."},
+			{Key: "region", Description: "The AWS region for the queue. The format of this field is \"aws:{region}\", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.", Format: AWSRegionFormat},
+			{Key: "aws_account", Description: "The AWS account number for the queue."},
+		},
+	},
+	BigQueryBIEngineModel: {
+		Type:        BigQueryBIEngineModel,
+		DisplayName: "BigQuery BI Engine Model",
+		Description: "BigQuery BI Engine Model.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Google Cloud location of the resource."},
+			{Key: "model_id", Description: "The identifier of the BI model."},
+		},
+	},
+	BigQueryDataset: {
+		Type:        BigQueryDataset,
+		DisplayName: "BigQuery Dataset",
+		Description: "A dataset in BigQuery.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "dataset_id", Description: "The name of the BigQuery dataset."},
+		},
+	},
+	BigQueryDTSConfig: {
+		Type:        BigQueryDTSConfig,
+		DisplayName: "BigQuery DTS Config",
+		Description: "A BigQuery Data Transfer Service configuration.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Google Cloud location of the resource"},
+			{Key: "config_id", Description: "The id of the DTS configuration."},
+		},
+	},
+	BigQueryDTSRun: {
+		Type:        BigQueryDTSRun,
+		DisplayName: "BigQuery DTS Run",
+		Description: "A BigQuery Data Transfer Service Run.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Google Cloud location of the resource"},
+			{Key: "config_id", Description: "The name of the DTS config that created the run."},
+			{Key: "run_id", Description: "The unique resource name of the BigQuery DTS run."},
+		},
+	},
+	BigQueryProject: {
+		Type:        BigQueryProject,
+		DisplayName: "BigQuery Project",
+		Description: "BigQuery Project.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "Location of the resource."},
+		},
+	},
+	BigQueryResource: {
+		Type:        BigQueryResource,
+		DisplayName: "BigQuery",
+		Description: "A BigQuery resource.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+		},
+	},
+	BigQueryTable: {
+		Type:        BigQueryTable,
+		DisplayName: "BigQuery Table",
+		Description: "An individual BigQuery table.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "dataset_id", Description: "The name of the BigQuery dataset."},
+			{Key: "table_id", Description: "The name of the BigQuery table."},
+		},
+	},
+	BillingAccount: {
+		Type:        BillingAccount,
+		DisplayName: "Cloud Billing Account",
+		Description: "A Cloud Billing Account.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "account_id", Description: "The unique id of the billing account."},
+		},
+	},
+	Build: {
+		Type:        Build,
+		DisplayName: "Cloud Build",
+		Description: "A build in Cloud Build.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "build_id", Description: "The unique id of the build."},
+			{Key: "build_trigger_id", Description: "The unique id of the build trigger."},
+		},
+	},
+	CertificateManagerProject: {
+		Type:        CertificateManagerProject,
+		DisplayName: "Certificate Manager project",
+		Description: "Certificate Manager project.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The GCP container associated with the resource."},
+			{Key: "location", Description: "GCP location."},
+		},
+	},
+	ClientAuthConfigBrand: {
+		Type:        ClientAuthConfigBrand,
+		DisplayName: "OAuth2 Brand",
+		Description: "Consent screen data shown to users during three-legged OAuth2 flows.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "brand_id", Description: "The unique id of the brand."},
+		},
+	},
+	ClientAuthConfigClient: {
+		Type:        ClientAuthConfigClient,
+		DisplayName: "OAuth2 Client",
+		Description: "A client used in OAuth2 flows.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "client_id", Description: "The unique id of the client."},
+		},
+	},
+	CloudComposerEnvironment: {
+		Type:        CloudComposerEnvironment,
+		DisplayName: "Cloud Composer Environment",
+		Description: "A Composer environment runs the managed Apache Airflow service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Cloud Composer location in which the environment is running."},
+			{Key: "environment_name", Description: "The user-specified environment name."},
+		},
+	},
+	CloudDataprocBatch: {
+		Type:        CloudDataprocBatch,
+		DisplayName: "Cloud Dataproc Batch",
+		Description: "A Dataproc batch execution.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Cloud Dataproc region to which the batch was submitted."},
+			{Key: "batch_id", Description: "The user-specified batch id."},
+		},
+	},
+	CloudDataprocCluster: {
+		Type:        CloudDataprocCluster,
+		DisplayName: "Cloud Dataproc Cluster",
+		Description: "A Dataproc cluster with separate cluster name and id labels.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "cluster_name", Description: "The user-specified cluster name."},
+			{Key: "cluster_uuid", Description: "The generated cluster id."},
+			{Key: "region", Description: "The Cloud Dataproc region in which the cluster is running."},
+		},
+	},
+	CloudDataprocJob: {
+		Type:        CloudDataprocJob,
+		DisplayName: "Cloud Dataproc Job",
+		Description: "A Dataproc job execution.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "region", Description: "The Cloud Dataproc region to which the job was submitted."},
+			{Key: "job_id", Description: "The user-specified job id."},
+			{Key: "job_uuid", Description: "The generated job uuid."},
+		},
+	},
+	CloudDebuggerResource: {
+		Type:        CloudDebuggerResource,
+		DisplayName: "Cloud Debugger",
+		Description: "A Google Cloud Debugger resource.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "app", Description: "The application to which the debugger is attached."},
+		},
+	},
+	CloudFunction: {
+		Type:        CloudFunction,
+		DisplayName: "Cloud Function",
+		Description: "A function in Google Cloud Functions.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "function_name", Description: "The short function name."},
+			{Key: "region", Description: "The region in which the function is running.", Format: GCPRegionFormat},
+		},
+	},
+	CloudRunJob: {
+		Type:        CloudRunJob,
+		DisplayName: "Cloud Run Job",
+		Description: "A job in Cloud Run.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "job_name", Description: "Name of the monitored job."},
+			{Key: "location", Description: "Region where the job exists.", Format: GCPRegionFormat},
+		},
+	},
+	CloudRunRevision: {
+		Type:        CloudRunRevision,
+		DisplayName: "Cloud Run Revision",
+		Description: "A revision in Cloud Run.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "service_name", Description: "Name of the service."},
+			{Key: "revision_name", Description: "Name of the monitored revision."},
+			{Key: "location", Description: "Region where the service is running.", Format: GCPRegionFormat},
+			{Key: "configuration_name", Description: "Name of the configuration which created the monitored revision."},
+		},
+	},
+	CloudSchedulerJob: {
+		Type:        CloudSchedulerJob,
+		DisplayName: "Cloud Scheduler Job",
+		Description: "A Cloud Scheduler Job.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The region of the job."},
+			{Key: "job_id", Description: "Identifier of the job."},
+		},
+	},
+	CloudTasksQueue: {
+		Type:        CloudTasksQueue,
+		DisplayName: "Cloud Tasks Queue",
+		Description: "A queue in Cloud Tasks.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "queue_id", Description: "The name of the queue."},
+			{Key: "target_type", Description: "The target type the queue is dispatching to."},
+			{Key: "location", Description: "The zone or region where the application is running."},
+		},
+	},
+	CloudDeployDeliveryPipeline: {
+		Type:        CloudDeployDeliveryPipeline,
+		DisplayName: "Cloud Deploy Delivery Pipeline",
+		Description: "A Cloud Deploy Delivery Pipeline.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the Google Cloud project associated with this resource."},
+			{Key: "location", Description: "The Google Cloud location where the resource resides."},
+			{Key: "pipeline_id", Description: "ID of the delivery pipeline resource."},
+		},
+	},
+	CloudIoTDevice: {
+		Type:        CloudIoTDevice,
+		DisplayName: "Cloud IoT Device",
+		Description: "A Device in Google Cloud IoT.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "device_num_id", Description: "The unique numeric identifier of the device."},
+			{Key: "device_registry_id", Description: "The user-defined string identifier of the device registry."},
+			{Key: "location", Description: "The cloud region of the device registry."},
+		},
+	},
+	CloudIoTDeviceRegistry: {
+		Type:        CloudIoTDeviceRegistry,
+		DisplayName: "Cloud IoT Registry",
+		Description: "A Device Registry in Google Cloud IoT.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "device_registry_id", Description: "The user-defined string identifier of the device registry."},
+			{Key: "location", Description: "The cloud region of the device registry."},
+		},
+	},
+	CloudKMSCryptoKey: {
+		Type:        CloudKMSCryptoKey,
+		DisplayName: "Cloud KMS CryptoKey",
+		Description: "Cryptographic key in the KMS.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The region Crypto Key belongs to."},
+			{Key: "key_ring_id", Description: "Key Ring the Crypto Key belongs to."},
+			{Key: "crypto_key_id", Description: "Crypto Key Identifier."},
+		},
+	},
+	CloudKMSCryptoKeyVersion: {
+		Type:        CloudKMSCryptoKeyVersion,
+		DisplayName: "Cloud KMS CryptoKeyVersion",
+		Description: "Version of a cryptographic key.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The region Crypto Key Version belongs to."},
+			{Key: "key_ring_id", Description: "Key Ring the Crypto Key Version belongs to."},
+			{Key: "crypto_key_id", Description: "Crypto Key the Crypto Key Version belongs to."},
+			{Key: "crypto_key_version_id", Description: "Crypto Key Version Identifier."},
+		},
+	},
+	CloudKMSKeyRing: {
+		Type:        CloudKMSKeyRing,
+		DisplayName: "Cloud KMS Key Ring",
+		Description: "Collection of cryptographic keys.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The region Key Ring belongs to."},
+			{Key: "key_ring_id", Description: "Key Ring Identifier."},
+		},
+	},
+	CloudMLModelVersion: {
+		Type:        CloudMLModelVersion,
+		DisplayName: "Cloud ML Model Version",
+		Description: "A Google Cloud ML model version.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "model_id", Description: "An immutable identifier for a model."},
+			{Key: "version_id", Description: "An immutable identifier for a version."},
+			{Key: "region", Description: "Cloud ML region."},
+		},
+	},
+	CloudSQLDatabase: {
+		Type:        CloudSQLDatabase,
+		DisplayName: "Cloud SQL Database",
+		Description: "A database hosted in Google Cloud SQL.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "database_id", Description: "The ID of the database."},
+			{Key: "region", Description: "The Google Cloud SQL region in which the database is running."},
+		},
+	},
+	NetAppCloudVolume: {
+		Type:        NetAppCloudVolume,
+		DisplayName: "Monitored Resource for NetApp CVS",
+		Description: "Monitored Resource for NetApp CVS.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "Project information."},
+			{Key: "location", Description: "Region/Zone information."},
+			{Key: "volume_id", Description: "ID of the volume."},
+			{Key: "service_type", Description: "Service type of the volume or replication relationship."},
+			{Key: "name", Description: "Name of the volume or replication relationship."},
+		},
+	},
+	ConsumedAPI: {
+		Type:        ConsumedAPI,
+		DisplayName: "Consumed API",
+		Description: "An API used by customers.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as 'my-project'."},
+			{Key: "service", Description: "The API service name, such as 'cloudsql.googleapis.com'."},
+			{Key: "method", Description: "The API method name, such as 'disks.list'."},
+			{Key: "version", Description: "The API version, such as 'v1'."},
+			{Key: "location", Description: "The service specific notion of location. This can be a name of a zone or region. If a service does not have any notion of zones then 'global' can be used."},
+			{Key: "credential_id", Description: "The client credential ID, such as an API key ID or the OAuth client ID."},
+		},
+	},
+	Container: {
+		Type:        Container,
+		DisplayName: "GKE Container",
+		Description: "A Google Kubernetes Engine (GKE) container instance.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "cluster_name", Description: "An immutable name for the cluster the container is running in."},
+			{Key: "namespace_id", Description: "Immutable ID of the cluster namespace the container is running in."},
+			{Key: "instance_id", Description: "Immutable ID of the GCE instance the container is running in."},
+			{Key: "pod_id", Description: "Immutable ID of the pod the container is running in."},
+			{Key: "container_name", Description: "Immutable name of the container."},
+			{Key: "zone", Description: "The GCE zone in which the instance is running."},
+		},
+	},
+	CSRRepository: {
+		Type:        CSRRepository,
+		DisplayName: "Cloud Source Repository",
+		Description: "A repository in Google Cloud Source Repositories.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "name", Description: "The name of the repository."},
+		},
+	},
+	DataflowStep: {
+		Type:        DataflowStep,
+		DisplayName: "Dataflow Step",
+		Description: "A step in a Dataflow job.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "job_id", Description: "The ID of the job."},
+			{Key: "step_id", Description: "The ID of the step."},
+			{Key: "job_name", Description: "The name of the job."},
+			{Key: "region", Description: "The region in which the job is running."},
+		},
+	},
+	DataMigrationMigrationJob: {
+		Type:        DataMigrationMigrationJob,
+		DisplayName: "Database migration service migration job",
+		Description: "Database migration service migration job.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The resource container (project ID)."},
+			{Key: "location", Description: "The location."},
+			{Key: "migration_job_id", Description: "The migration job ID."},
+		},
+	},
+	DataplexEnvironment: {
+		Type:        DataplexEnvironment,
+		DisplayName: "Cloud Dataplex Environment",
+		Description: "An Environment within a Cloud Dataplex Lake.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of GCP project associated with this resource."},
+			{Key: "location", Description: "The GCP region associated with this resource."},
+			{Key: "lake_id", Description: "The identifier of the Lake resource containing this resource."},
+			{Key: "environment_id", Description: "The identifier of this Environment resource."},
+		},
+	},
+	DataplexLake: {
+		Type:        DataplexLake,
+		DisplayName: "Cloud Dataplex Lake",
+		Description: "A Cloud Dataplex Lake.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of GCP project associated with this resource."},
+			{Key: "location", Description: "The GCP region associated with this resource."},
+			{Key: "lake_id", Description: "The identifier of this Lake resource."},
+		},
+	},
+	DataplexTask: {
+		Type:        DataplexTask,
+		DisplayName: "Cloud Dataplex Task",
+		Description: "A Task within a Cloud Dataplex Lake.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of GCP project associated with this resource."},
+			{Key: "location", Description: "The GCP region associated with this resource."},
+			{Key: "lake_id", Description: "The identifier of the Lake resource containing this resource."},
+			{Key: "task_id", Description: "The identifier of this Task resource."},
+		},
+	},
+	DataplexZone: {
+		Type:        DataplexZone,
+		DisplayName: "Cloud Dataplex Zone",
+		Description: "A Zone within a Cloud Dataplex Lake.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of GCP project associated with this resource."},
+			{Key: "location", Description: "The GCP region associated with this resource."},
+			{Key: "lake_id", Description: "The identifier of the Lake resource containing this resource."},
+			{Key: "zone_id", Description: "The identifier of this Zone resource."},
+		},
+	},
+	DataprocCluster: {
+		Type:        DataprocCluster,
+		DisplayName: "Dataproc Cluster",
+		Description: "A Dataproc cluster.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "cluster_id", Description: "The cluster ID, concatenated from the cluster name and uuid"},
+			{Key: "zone", Description: "The GCE zone in which the instance is running."},
+		},
+	},
+	DatastoreDatabase: {
+		Type:        DatastoreDatabase,
+		DisplayName: "Cloud Datastore Database",
+		Description: "A Cloud Datastore database.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "database_id", Description: "The unique id of the database."},
+		},
+	},
+	DatastoreIndex: {
+		Type:        DatastoreIndex,
+		DisplayName: "Cloud Datastore Index",
+		Description: "A Cloud Datastore index.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "database_id", Description: "The database the index belongs to."},
+			{Key: "index_id", Description: "The unique id of the index."},
+		},
+	},
+	DatastreamStream: {
+		Type:        DatastreamStream,
+		DisplayName: "Datastream Stream",
+		Description: "A Datastream stream.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The resource container (project ID)."},
+			{Key: "location", Description: "The location."},
+			{Key: "stream_id", Description: "The stream ID."},
+		},
+	},
+	Deployment: {
+		Type:        Deployment,
+		DisplayName: "Deployment",
+		Description: "A Deployment Manager deployment.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "name", Description: "Name that uniquely identifies the deployment within a project."},
+		},
+	},
+	DeploymentManagerManifest: {
+		Type:        DeploymentManagerManifest,
+		DisplayName: "Deployment Manager Manifest",
+		Description: "A Deployment Manager manifest which is used to specify the contents of a deployment.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "manifest_name", Description: "Name that uniquely identifies the manifest within a project."},
+			{Key: "deployment_name", Description: "Name of the deployment."},
+		},
+	},
+	DeploymentManagerOperation: {
+		Type:        DeploymentManagerOperation,
+		DisplayName: "Deployment Manager Operation",
+		Description: "A Deployment Manager operation.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "operation_name", Description: "Name that uniquely identifies the operation within a project."},
+		},
+	},
+	DeploymentManagerResource: {
+		Type:        DeploymentManagerResource,
+		DisplayName: "Deployment Manager Resource",
+		Description: "Deployment Manager's record of Google Cloud Platform resources in a Deployment, such as a VM or a bucket.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "resource_name", Description: "Name of the resource, unique within a deployment."},
+			{Key: "deployment_name", Description: "Name of the deployment."},
+		},
+	},
+	DeploymentManagerType: {
+		Type:        DeploymentManagerType,
+		DisplayName: "Deployment Manager Type",
+		Description: "A Deployment Manager type.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "name", Description: "Name that uniquely identifies the type within a project."},
+		},
+	},
+	DNSManagedZone: {
+		Type:        DNSManagedZone,
+		DisplayName: "Managed DNS Zone",
+		Description: "A ManagedZone in the Google Cloud DNS service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "zone_name", Description: "The name of the ManagedZone."},
+			{Key: "location", Description: "The location field is provided for compatibility with other GCP services. Its value is always set to 'global'"},
+		},
+	},
+	DNSPolicy: {
+		Type:        DNSPolicy,
+		DisplayName: "Cloud DNS Policy",
+		Description: "A Policy in the Google Cloud DNS service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "policy_name", Description: "The name of the Policy."},
+			{Key: "location", Description: "The location field is provided for compatibility with other GCP services. Its value is always set to 'global'"},
+		},
+	},
+	DNSQuery: {
+		Type:        DNSQuery,
+		DisplayName: "Cloud DNS Query",
+		Description: "A DNS query to a private DNS handled by the Google Cloud DNS service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "target_name", Description: "The DNS name managed by Cloud DNS to be resolved (e.g. the zone name, policy name, internal domain name). External names will have the value \"external\""},
+			{Key: "location", Description: "The GCP zone where the DNS request was received (e.g. us-east1, us-west1)."},
+			{Key: "target_type", Description: "The target of the resolution of the DNS query (e.g. public-zone, private-zone, external)."},
+			{Key: "source_type", Description: "Source of the query (e.g. gce-vm, internet)."},
+		},
+	},
+	FirebaseDomain: {
+		Type:        FirebaseDomain,
+		DisplayName: "Firebase Hosting Site Domain",
+		Description: "A domain from which a Firebase Hosting site is serving traffic.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as 'my-project'."},
+			{Key: "site_name", Description: "The name of a Firebase Hosting site, that is the subdomain in .web.app."},
+			{Key: "domain_name", Description: "The default subdomain (on web.app or firebaseapp.com) or custom domain from which content was served."},
+		},
+	},
+	FirebaseNamespace: {
+		Type:        FirebaseNamespace,
+		DisplayName: "Firebase Realtime Database",
+		Description: "A Firebase Realtime Database.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "table_name", Description: "The name of the database."},
+			{Key: "location", Description: "The location of the database."},
+		},
+	},
+	FleetEngineFleet: {
+		Type:        FleetEngineFleet,
+		DisplayName: "Fleet Engine On Demand Rides and Deliveries",
+		Description: "A top-level resource for Fleet Engine On Demand Rides and Deliveries metrics and logs.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP container associated with Fleet."},
+			{Key: "location", Description: "The region in which the Fleet Engine instance is running."},
+		},
+	},
+	Folder: {
+		Type:        Folder,
+		DisplayName: "Google Folder",
+		Description: "A Google Cloud Platform folder.",
+		Labels: []LabelDescriptor{
+			{Key: "folder_id", Description: "Numeric id of the folder."},
+		},
+	},
+	GAEApp: {
+		Type:        GAEApp,
+		DisplayName: "GAE Application",
+		Description: "An application running in Google App Engine (GAE).",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "module_id", Description: "The service/module name."},
+			{Key: "version_id", Description: "The version name."},
+			{Key: "zone", Description: "The GAE zone where the application is running."},
+		},
+	},
+	GatewayScope: {
+		Type:        GatewayScope,
+		DisplayName: "Gateway Scope",
+		Description: "GatewayScope represents a set of Gateways with the same merged configs.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The location of the control plane"},
+			{Key: "scope", Description: "The name of the gateway_scope"},
+		},
+	},
+	GCEAutoscaler: {
+		Type:        GCEAutoscaler,
+		DisplayName: "GCE Autoscaler",
+		Description: "A Google Compute Engine (GCE) autoscaler.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "autoscaler_id", Description: "Unique identifier of the autoscaler."},
+			{Key: "location", Description: "GCE zone or region where the autoscaler is running."},
+		},
+	},
+	GCEBackendBucket: {
+		Type:        GCEBackendBucket,
+		DisplayName: "GCE Backend Bucket",
+		Description: "A Google Compute Engine (GCE) backend bucket.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "backend_bucket_id", Description: "Unique identifier of the backend bucket."},
+		},
+	},
+	GCEBackendService: {
+		Type:        GCEBackendService,
+		DisplayName: "Compute Engine Backend Service",
+		Description: "A Compute Engine backend service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "backend_service_id", Description: "Unique identifier of the backend service."},
+			{Key: "location", Description: "Global or Compute Engine region containing the backend service"},
+		},
+	},
+	GCEClientSSLPolicy: {
+		Type:        GCEClientSSLPolicy,
+		DisplayName: "GCE Client SSL Policy",
+		Description: "A Google Compute Engine (GCE) client SSL policy.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "client_ssl_policy_id", Description: "Unique identifier of the client SSL policy."},
+		},
+	},
+	GCECommitment: {
+		Type:        GCECommitment,
+		DisplayName: "GCE Committed Use Discount",
+		Description: "A Google Compute Engine (GCE) committed use discount.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "commitment_id", Description: "Unique identifier of the committed use discount."},
+			{Key: "location", Description: "GCE region where the committed use discount is active."},
+		},
+	},
+	GCEDisk: {
+		Type:        GCEDisk,
+		DisplayName: "Disk",
+		Description: "A disk belonging to a Compute Engine instance.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "disk_id", Description: "Unique identifier of the disk."},
+			{Key: "zone", Description: "The Compute Engine zone where the disk resides."},
+		},
+	},
+	GCEFirewallRule: {
+		Type:        GCEFirewallRule,
+		DisplayName: "GCE Firewall Rule",
+		Description: "A Google Compute Engine (GCE) firewall rule.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "firewall_rule_id", Description: "Unique identifier of the firewall rule."},
+		},
+	},
+	GCEForwardingRule: {
+		Type:        GCEForwardingRule,
+		DisplayName: "GCE Forwarding Rule",
+		Description: "A Google Compute Engine (GCE) Forwarding Rule.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "forwarding_rule_id", Description: "Unique identifier of the forewarding rule."},
+			{Key: "region", Description: "GCE region where the forwarding rule resides."},
+		},
+	},
+	GCEHealthCheck: {
+		Type:        GCEHealthCheck,
+		DisplayName: "GCE Health Check",
+		Description: "A Google Compute Engine (GCE) health check.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "health_check_id", Description: "Unique identifier of the health check."},
+		},
+	},
+	GCEImage: {
+		Type:        GCEImage,
+		DisplayName: "GCE Image",
+		Description: "A Google Compute Engine (GCE) image resource.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "image_id", Description: "Unique numerical identifier of the image."},
+		},
+	},
+	GCEInstance: {
+		Type:        GCEInstance,
+		DisplayName: "VM Instance",
+		Description: "A virtual machine instance hosted in Compute Engine.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "instance_id", Description: "The numeric VM instance identifier assigned by Compute Engine."},
+			{Key: "zone", Description: "The Compute Engine zone in which the VM is running.", Format: GCPZoneFormat},
+		},
+	},
+	GCEInstanceGroup: {
+		Type:        GCEInstanceGroup,
+		DisplayName: "GCE Instance Group",
+		Description: "A Google Compute Engine (GCE) instance group resource.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "instance_group_id", Description: "The unique numerical identifier of the instance group."},
+			{Key: "instance_group_name", Description: "The unique user provided name of the instance group."},
+			{Key: "location", Description: "GCE zone containing the instance group.", Format: GCPZoneFormat},
+		},
+	},
+	GCEInstanceGroupManager: {
+		Type:        GCEInstanceGroupManager,
+		DisplayName: "GCE Instance Group Manager",
+		Description: "A Google Compute Engine (GCE) instance group manager resource.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "instance_group_manager_id", Description: "The unique numerical identifier of the instance group manager."},
+			{Key: "instance_group_manager_name", Description: "The unique user provided name of the instance group manager."},
+			{Key: "location", Description: "GCE zone or region where the instance group manager is located.", Format: GCPLocationFormat},
+		},
+	},
+	GCEInstanceTemplate: {
+		Type:        GCEInstanceTemplate,
+		DisplayName: "GCE Instance Template",
+		Description: "A Google Compute Engine (GCE) instance template resource.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "instance_template_id", Description: "The unique numerical identifier of the instance template."},
+			{Key: "instance_template_name", Description: "The unique user provided name of the instance template."},
+		},
+	},
+	GCELicense: {
+		Type:        GCELicense,
+		DisplayName: "GCE License",
+		Description: "A Google Compute Engine (GCE) license.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "license_id", Description: "Unique identifier of the license."},
+		},
+	},
+	GCENetwork: {
+		Type:        GCENetwork,
+		DisplayName: "GCE Network",
+		Description: "A Google Compute Engine (GCE) network.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "network_id", Description: "Unique identifier of the network."},
+		},
+	},
+	GCENetworkEndpointGroup: {
+		Type:        GCENetworkEndpointGroup,
+		DisplayName: "Network Endpoint Group",
+		Description: "A Compute Engine network endpoint group resource.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "zone", Description: "The name of the zone where the network endpoint group is located."},
+			{Key: "network_endpoint_group_id", Description: "The ID of the network endpoint group."},
+		},
+	},
+	GCENetworkRegion: {
+		Type:        GCENetworkRegion,
+		DisplayName: "Network Region",
+		Description: "A region of a Compute Engine network.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "network_id", Description: "The ID of the Compute Engine network."},
+			{Key: "region", Description: "The name of the network region."},
+		},
+	},
+	GCENodeGroup: {
+		Type:        GCENodeGroup,
+		DisplayName: "Node Group",
+		Description: "A Compute Engine node group.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "node_group_id", Description: "Unique identifier of the node group."},
+			{Key: "zone", Description: "Zone of the node group."},
+		},
+	},
+	GCENodeTemplate: {
+		Type:        GCENodeTemplate,
+		DisplayName: "Node Template",
+		Description: "A Compute Engine node template.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "node_template_id", Description: "Unique identifier of the node template."},
+			{Key: "region", Description: "Region of the node template."},
+		},
+	},
+	GCEOperation: {
+		Type:        GCEOperation,
+		DisplayName: "GCE Operation",
+		Description: "A Google Compute Engine (GCE) operation resource.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "operation_name", Description: "The unique user provided name of the operation."},
+			{Key: "location", Description: "Location of the resource."},
+		},
+	},
+	GCEPacketMirroring: {
+		Type:        GCEPacketMirroring,
+		DisplayName: "GCE Packet Mirroring",
+		Description: "A Google Compute Engine (GCE) packet mirroring.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "packet_mirroring_id", Description: "Unique identifier of the packet mirroring."},
+			{Key: "region", Description: "Region of the packet mirroring."},
+		},
+	},
+	GCEProject: {
+		Type:        GCEProject,
+		DisplayName: "GCE Project",
+		Description: "A Google Compute Engine (GCE) project resource.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "GCE specific numeric identifier of the GCE project resource."},
+		},
+	},
+	GCEReservedAddress: {
+		Type:        GCEReservedAddress,
+		DisplayName: "GCE Reserved Address",
+		Description: "A Google Compute Engine reserved address.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "reserved_address_id", Description: "Unique identifier of the reserved address."},
+			{Key: "location", Description: "Global or GCE region containing the reserved address"},
+		},
+	},
+	GCEResourcePolicy: {
+		Type:        GCEResourcePolicy,
+		DisplayName: "Resource Policy",
+		Description: "A Compute Engine resource policy.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "resource_policy_id", Description: "Unique identifier of the resource policy."},
+			{Key: "region", Description: "Region of the resource policy."},
+		},
+	},
+	GCERoute: {
+		Type:        GCERoute,
+		DisplayName: "GCE Route",
+		Description: "A Google Compute Engine (GCE) route.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "route_id", Description: "Unique identifier of the route."},
+		},
+	},
+	GCERouter: {
+		Type:        GCERouter,
+		DisplayName: "GCE Router",
+		Description: "A Google Compute Engine (GCE) router.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "router_id", Description: "Unique identifier of the router."},
+			{Key: "region", Description: "Region of the router."},
+		},
+	},
+	GCESnapshot: {
+		Type:        GCESnapshot,
+		DisplayName: "GCE Snapshot",
+		Description: "A Google Compute Engine (GCE) snapshot.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "snapshot_id", Description: "Unique identifier of the snapshot."},
+		},
+	},
+	GCESSLCertificate: {
+		Type:        GCESSLCertificate,
+		DisplayName: "GCE SSL Certificate",
+		Description: "A Google Compute Engine (GCE) SSL certificate.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "ssl_certificate_id", Description: "The unique numerical identifier of the SSL certificate."},
+			{Key: "ssl_certificate_name", Description: "The unique user provided name of the SSL Certificate."},
+		},
+	},
+	GCESubnetwork: {
+		Type:        GCESubnetwork,
+		DisplayName: "Subnetwork",
+		Description: "A Compute Engine subnetwork.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "subnetwork_id", Description: "The unique numerical identifier of the subnetwork."},
+			{Key: "subnetwork_name", Description: "The unique user provided name of the subnetwork."},
+			{Key: "location", Description: "Location of the resource."},
+		},
+	},
+	GCETargetHTTPInstance: {
+		Type:        GCETargetHTTPInstance,
+		DisplayName: "GCE Target HTTP Instance",
+		Description: "A Google Compute Engine (GCE) target http instance.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "target_http_instance_id", Description: "Unique identifier of the target http instance."},
+			{Key: "zone", Description: "GCE zone where the target http instance resides."},
+		},
+	},
+	GCETargetHTTPProxy: {
+		Type:        GCETargetHTTPProxy,
+		DisplayName: "GCE Target HTTP Proxy",
+		Description: "A Google Compute Engine (GCE) target http proxy.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "target_http_proxy_id", Description: "Unique identifier of the target http proxy."},
+		},
+	},
+	GCETargetHTTPSProxy: {
+		Type:        GCETargetHTTPSProxy,
+		DisplayName: "GCE Target HTTPS Proxy",
+		Description: "A Google Compute Engine (GCE) target https proxy.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "target_https_proxy_id", Description: "Unique identifier of the target https proxy."},
+		},
+	},
+	GCETargetPool: {
+		Type:        GCETargetPool,
+		DisplayName: "GCE Target Pool",
+		Description: "A Google Compute Engine (GCE) target pool.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "target_pool_id", Description: "Unique identifier of the target pool."},
+			{Key: "zone", Description: "GCE zone where the pool resides."},
+		},
+	},
+	GCETargetSSLProxy: {
+		Type:        GCETargetSSLProxy,
+		DisplayName: "GCE Target SSL Proxy",
+		Description: "A Google Compute Engine (GCE) target SSL proxy.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "target_ssl_proxy_id", Description: "Unique identifier of the target ssl proxy."},
+		},
+	},
+	GCEURLMap: {
+		Type:        GCEURLMap,
+		DisplayName: "GCE URL Map",
+		Description: "A Google Compute Engine (GCE) URL map.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "url_map_id", Description: "Unique identifier of the url map."},
+		},
+	},
+	GCSBucket: {
+		Type:        GCSBucket,
+		DisplayName: "GCS Bucket",
+		Description: "A Google Cloud Storage (GCS) bucket.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "bucket_name", Description: "An immutable name of the bucket."},
+			{Key: "location", Description: "Location of the bucket."},
+		},
+	},
+	GenericNode: {
+		Type:        GenericNode,
+		DisplayName: "Generic Node",
+		Description: "A generic node identifies a machine or other computational resource for which no more specific resource type is applicable. The label values must uniquely identify the node.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The GCP or AWS region in which data about the resource is stored. For example, \"us-east1-a\" (GCP) or \"aws:us-east-1a\" (AWS).", Optional: true, Format: LocationFormat},
+			{Key: "namespace", Description: "A namespace identifier, such as a cluster name.", Optional: true},
+			{Key: "node_id", Description: "A unique identifier for the node within the namespace, such as a hostname or IP address."},
+		},
+	},
+	GenericTask: {
+		Type:        GenericTask,
+		DisplayName: "Generic Task",
+		Description: "A generic task identifies an application process for which no more specific resource is applicable, such as a process scheduled by a custom orchestration system. The label values must uniquely identify the task.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The GCP or AWS region in which data about the resource is stored. For example, \"us-east1-a\" (GCP) or \"aws:us-east-1a\" (AWS).", Optional: true, Format: LocationFormat},
+			{Key: "namespace", Description: "A namespace identifier, such as a cluster name.", Optional: true},
+			{Key: "job", Description: "An identifier for a grouping of related tasks, such as the name of a microservice or distributed batch job."},
+			{Key: "task_id", Description: "A unique identifier for the task within the namespace and job, such as a replica index identifying the task within the job."},
+		},
+	},
+	GenomicsDataset: {
+		Type:        GenomicsDataset,
+		DisplayName: "Genomics Dataset",
+		Description: "A dataset in the Google Genomics service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "dataset_id", Description: "Unique identifier of the dataset."},
+		},
+	},
+	GenomicsOperation: {
+		Type:        GenomicsOperation,
+		DisplayName: "Genomics Operation",
+		Description: "A long running operation in the Google Genomics service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "operation_id", Description: "Unique identifier of the long running operation."},
+		},
+	},
+	GKECluster: {
+		Type:        GKECluster,
+		DisplayName: "GKE Cluster Operations",
+		Description: "A Google Kubernetes Engine (GKE) Cluster. It contains events and audit logs about cluster operations.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "cluster_name", Description: "The name of the GKE Cluster."},
+			{Key: "location", Description: "The location in which the GKE Cluster is running."},
+		},
+	},
+	GKENodePool: {
+		Type:        GKENodePool,
+		DisplayName: "GKE Node Pool Operations",
+		Description: "A Google Kubernetes Engine (GKE) Node Pool. It contains audit logs about Node Pool operations.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "nodepool_name", Description: "The name of the GKE Node Pool."},
+			{Key: "location", Description: "The location in which the GKE Cluster is running."},
+			{Key: "cluster_name", Description: "The name of the GKE Cluster to which this Node Pool belongs."},
+		},
+	},
+	GKEBackupBackupPlan: {
+		Type:        GKEBackupBackupPlan,
+		DisplayName: "GKE Backup Plan",
+		Description: "A backup plan provides configuration, location, and management functions for a sequence of backups.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the Google Cloud container associated with the resource."},
+			{Key: "location", Description: "The Google Cloud location where this backupPlan resides."},
+			{Key: "backup_plan_id", Description: "The name of the backupPlan."},
+		},
+	},
+	GKEBackupRestorePlan: {
+		Type:        GKEBackupRestorePlan,
+		DisplayName: "GKE Restore Plan",
+		Description: "A restore plan defines the configuration of a series of restore operations to be performed against backups which belong to the specified backup plan.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the Google Cloud container associated with the resource."},
+			{Key: "location", Description: "The Google Cloud location where this restorePlan resides."},
+			{Key: "restore_plan_id", Description: "The name of the restorePlan."},
+		},
+	},
+	Global: {
+		Type:        Global,
+		DisplayName: "Global",
+		Description: "A resource type used to indicate that a log is not associated with any specific resource.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+		},
+	},
+	HealthcareAnnotationStore: {
+		Type:        HealthcareAnnotationStore,
+		DisplayName: "Healthcare Annotation Store",
+		Description: "A Cloud Healthcare Annotation store containing Annotation records.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Google Cloud location of the dataset that contains the Annotation store."},
+			{Key: "dataset_id", Description: "The ID of the dataset."},
+			{Key: "annotation_store_id", Description: "The ID of the Annotation store."},
+		},
+	},
+	HealthcareConsentStore: {
+		Type:        HealthcareConsentStore,
+		DisplayName: "Healthcare Consent Store",
+		Description: "A Cloud Healthcare Consent store containing consent records.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Google Cloud location of the dataset that contains the Consent store."},
+			{Key: "dataset_id", Description: "The ID of the dataset."},
+			{Key: "consent_store_id", Description: "The ID of the Consent store."},
+		},
+	},
+	HealthcareDataset: {
+		Type:        HealthcareDataset,
+		DisplayName: "Healthcare Dataset",
+		Description: "A Cloud Healthcare dataset.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Google Cloud location of the dataset."},
+			{Key: "dataset_id", Description: "The ID of the dataset."},
+		},
+	},
+	HealthcareDICOMStore: {
+		Type:        HealthcareDICOMStore,
+		DisplayName: "Healthcare DICOM Store",
+		Description: "A Cloud Healthcare DICOM store containing DICOM instances.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Google Cloud location of the dataset that contains the DICOM store."},
+			{Key: "dataset_id", Description: "The ID of the dataset."},
+			{Key: "dicom_store_id", Description: "The ID of the DICOM store."},
+		},
+	},
+	HealthcareFHIRStore: {
+		Type:        HealthcareFHIRStore,
+		DisplayName: "Healthcare FHIR Store",
+		Description: "A Cloud Healthcare FHIR store containing FHIR resources representing electronic medical information.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Google Cloud location of the dataset that contains the FHIR store."},
+			{Key: "dataset_id", Description: "The ID of the dataset."},
+			{Key: "fhir_store_id", Description: "The ID of the FHIR store."},
+		},
+	},
+	HealthcareHL7v2Store: {
+		Type:        HealthcareHL7v2Store,
+		DisplayName: "Healthcare HL7v2 Store",
+		Description: "A Cloud Healthcare HL7v2 store containing clinical messages.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The Google Cloud location of the dataset that contains the HL7v2 store."},
+			{Key: "dataset_id", Description: "The ID of the dataset."},
+			{Key: "hl7v2_store_id", Description: "The ID of the HL7v2 store."},
+		},
+	},
+	HTTPExternalRegionalLBRule: {
+		Type:        HTTPExternalRegionalLBRule,
+		DisplayName: "HTTP/S External Regional Load Balancing Rule",
+		Description: "A resource descriptor for HTTP/S External Regional load balancing behavior.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the Google Cloud project associated with this resource, such as 'my-project'."},
+			{Key: "network_name", Description: "The name of the customer network in which the Load Balancer resides."},
+			{Key: "region", Description: "The region under which the Load Balancer is defined."},
+			{Key: "url_map_name", Description: "The name of the urlmap."},
+			{Key: "forwarding_rule_name", Description: "The name of the forwarding rule."},
+			{Key: "target_proxy_name", Description: "The name of the target HTTP/S proxy."},
+			{Key: "matched_url_path_rule", Description: "The prefix of URL defined in urlmap tree. 'UNMATCHED' for the sink default rule."},
+			{Key: "backend_target_name", Description: "The name of the backend target or service."},
+			{Key: "backend_target_type", Description: "The type of the backend target. Can be 'BACKEND_SERVICE', or 'UNKNOWN' if the backend wasn't assigned."},
+			{Key: "backend_name", Description: "The name of the backend group. Can be '' if the backend wasn't assigned."},
+			{Key: "backend_type", Description: "The type of the backend group. Can be 'INSTANCE_GROUP', 'NETWORK_ENDPOINT_GROUP', or 'UNKNOWN' if the backend wasn't assigned."},
+			{Key: "backend_scope", Description: "The scope of the backend group. Can be 'UNKNOWN' if the backend wasn't assigned."},
+			{Key: "backend_scope_type", Description: "The type of the scope of the backend group. Can be 'ZONE', 'REGION', or 'UNKNOWN' in case the backend wasn't assigned."},
+		},
+	},
+	HTTPLoadBalancer: {
+		Type:        HTTPLoadBalancer,
+		DisplayName: "Cloud HTTP Load Balancer",
+		Description: "A Cloud HTTP Load Balancer Instance.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "forwarding_rule_name", Description: "The name of the forwarding rule."},
+			{Key: "url_map_name", Description: "The name of the urlmap."},
+			{Key: "target_proxy_name", Description: "The name of the target proxy."},
+			{Key: "backend_service_name", Description: "The name of the backend service."},
+			{Key: "zone", Description: "The zone in which the load balancer is running."},
+		},
+	},
+	IAMRole: {
+		Type:        IAMRole,
+		DisplayName: "IAM Role",
+		Description: "An IAM role.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "role_name", Description: "The name of the IAM custom role; this labelappears only on custom roles.(e.g., roles/[CUSTOM_ROLE],organizations/123456/roles/[CUSTOM_ROLE],projects/myproject/roles/[CUSTOM_ROLE])."},
+		},
+	},
+	IdentityToolkitProject: {
+		Type:        IdentityToolkitProject,
+		DisplayName: "Project",
+		Description: "An Identity Toolkit project.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource."},
+		},
+	},
+	IdentityToolkitTenant: {
+		Type:        IdentityToolkitTenant,
+		DisplayName: "Identity Toolkit Tenant",
+		Description: "An Identity Toolkit tenant.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource."},
+			{Key: "tenant_name", Description: "The name of the tenant."},
+		},
+	},
+	IDSEndpoint: {
+		Type:        IDSEndpoint,
+		DisplayName: "IDS Endpoint",
+		Description: "A Cloud IDS Endpoint.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP project owning the Endpoint."},
+			{Key: "location", Description: "The zone of the IDS Endpoint."},
+			{Key: "id", Description: "The ID of the Endpoint."},
+		},
+	},
+	IstioControlPlane: {
+		Type:        IstioControlPlane,
+		DisplayName: "Istio Control Plane",
+		Description: "An Istio Control Plane is an instance of a service that provides xDS and related functionality to a set of managed Istio proxies.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "mesh_uid", Description: "Unique identifier for an Istio service mesh."},
+			{Key: "location", Description: "The physical location in which the workload for the Control Plane is located."},
+			{Key: "revision", Description: "Immutable revision of Istio managed by the Control Plane."},
+			{Key: "build_id", Description: "Immutable build tag for the instance of the Control Plane."},
+			{Key: "owner", Description: "Immutable name of the owner of the Control Plane."},
+		},
+	},
+	K8sCluster: {
+		Type:        K8sCluster,
+		DisplayName: "Kubernetes Cluster",
+		Description: "A Kubernetes cluster. It contains Kubernetes audit logs from the cluster.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The physical location of the cluster.", Format: GCPLocationFormat},
+			{Key: "cluster_name", Description: "The name of the cluster."},
+		},
+	},
+	K8sContainer: {
+		Type:        K8sContainer,
+		DisplayName: "Kubernetes Container",
+		Description: "A Kubernetes container instance.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The physical location of the cluster that contains the container.", Format: GCPLocationFormat},
+			{Key: "cluster_name", Description: "The name of the cluster that the container is running in."},
+			{Key: "namespace_name", Description: "The name of the namespace that the container is running in."},
+			{Key: "pod_name", Description: "The name of the pod that the container is running in."},
+			{Key: "container_name", Description: "The name of the container."},
+		},
+	},
+	K8sControlPlaneComponent: {
+		Type:        K8sControlPlaneComponent,
+		DisplayName: "Kubernetes Control Plane Component",
+		Description: "A Kubernetes Control Plane component.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The physical location of the cluster that contains the control plane component.", Format: GCPLocationFormat},
+			{Key: "cluster_name", Description: "The name of the cluster that the control plane component is running in."},
+			{Key: "component_name", Description: "The name of the control plane component."},
+			{Key: "component_location", Description: "The physical location where the control plane component is running."},
+		},
+	},
+	K8sNode: {
+		Type:        K8sNode,
+		DisplayName: "Kubernetes Node",
+		Description: "A Kubernetes node instance.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The physical location of the cluster that contains the node.", Format: GCPLocationFormat},
+			{Key: "cluster_name", Description: "The name of the cluster that the node is a part of."},
+			{Key: "node_name", Description: "The name of the node."},
+		},
+	},
+	K8sPod: {
+		Type:        K8sPod,
+		DisplayName: "Kubernetes Pod",
+		Description: "A Kubernetes pod instance.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The physical location of the cluster that contains the pod.", Format: GCPLocationFormat},
+			{Key: "cluster_name", Description: "The name of the cluster that the pod is running in."},
+			{Key: "namespace_name", Description: "The name of the namespace that the pod is running in."},
+			{Key: "pod_name", Description: "The name of the pod."},
+		},
+	},
+	K8sService: {
+		Type:        K8sService,
+		DisplayName: "Kubernetes Service",
+		Description: "A Kubernetes Service instance.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The physical location of the cluster that contains the service.", Format: GCPLocationFormat},
+			{Key: "cluster_name", Description: "The name of the cluster that the service is running in."},
+			{Key: "namespace_name", Description: "The name of the namespace that the service is running in."},
+			{Key: "service_name", Description: "The name of the service."},
+		},
+	},
+	L4ProxyRule: {
+		Type:        L4ProxyRule,
+		DisplayName: "Layer 4 Proxying Rule for TCP/UDP/SSL Traffic",
+		Description: "A resource descriptor for TCP/SSL/UDP Internal Regional load balancing behavior.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the Google Cloud project associated with this resource, such as 'my-project'."},
+			{Key: "network_name", Description: "The name of the customer network in which the Load Balancer resides."},
+			{Key: "region", Description: "The region under which the Load Balancer is defined."},
+			{Key: "load_balancing_scheme", Description: "The load balancing scheme associated with the forwarding rule, one of [INTERNAL_MANAGED, EXTERNAL_MANAGED]."},
+			{Key: "protocol", Description: "The protocol associated with the traffic processed by the proxy, one of [TCP, UDP, SSL, UNKNOWN]."},
+			{Key: "forwarding_rule_name", Description: "The name of the forwarding rule."},
+			{Key: "target_proxy_name", Description: "The name of the target proxy."},
+			{Key: "backend_target_name", Description: "The name of the backend target or service."},
+			{Key: "backend_target_type", Description: "The type of the backend target, one of ['BACKEND_SERVICE'; 'UNKNOWN' - if the backend wasn't assigned]."},
+			{Key: "backend_name", Description: "The name of the backend group. Can be '' if the backend wasn't assigned."},
+			{Key: "backend_type", Description: "The type of the backend group, one of ['INSTANCE_GROUP'; 'NETWORK_ENDPOINT_GROUP'; 'UNKNOWN' - if the backend wasn't assigned]."},
+			{Key: "backend_scope", Description: "The scope of the backend group. Can be 'UNKNOWN' if the backend wasn't assigned."},
+			{Key: "backend_scope_type", Description: "The type of the scope of the backend group, one of ['ZONE'; 'REGION'; 'UNKNOWN' - in case the backend wasn't assigned]."},
+		},
+	},
+	LiveStreamChannel: {
+		Type:        LiveStreamChannel,
+		DisplayName: "Live Stream API Channel",
+		Description: "A Live Stream API Channel.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP project associated with this channel resource."},
+			{Key: "location", Description: "The GCP location where the channel resource resides."},
+			{Key: "channel_id", Description: "ID of the channel resource."},
+		},
+	},
+	LoadBalancingExternalNetworkLoadBalancerRule: {
+		Type:        LoadBalancingExternalNetworkLoadBalancerRule,
+		DisplayName: "Google Cloud External Network Load Balancer Rule",
+		Description: "A set of definitions for multi protocol network load balancing behavior.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "region", Description: "The Google Cloud Platform region of the backend instance that connected to network load balancing forwarding rule."},
+			{Key: "backend_network_name", Description: "The network name of the NIC of the instance that received the Net LB flow."},
+			{Key: "backend_target_type", Description: "The type of the backend target that handled the connection."},
+			{Key: "backend_service_name", Description: "The name of the backend service that handled the connection."},
+			{Key: "primary_target_pool", Description: "The name of the primary target pool."},
+			{Key: "target_pool", Description: "The name of the target pool."},
+			{Key: "forwarding_rule_name", Description: "The name of the forwarding rule."},
+			{Key: "backend_group_name", Description: "The name of the backend group that handled the connection."},
+			{Key: "backend_group_type", Description: "The type of the backend group that handled the connection."},
+			{Key: "backend_group_scope", Description: "The scope (zone or region) of the backend group that handled the connection."},
+			{Key: "backend_subnetwork_name", Description: "The name of the subnetwork of the instance that handled the connection."},
+			{Key: "backend_zone", Description: "The zone of the endpoint (VM instance) that handled the connection."},
+		},
+	},
+	LoadBalancingInternalNetworkLoadBalancerRule: {
+		Type:        LoadBalancingInternalNetworkLoadBalancerRule,
+		DisplayName: "Google Cloud Internal Network Load Balancer Rule",
+		Description: "A set of definitions for multi protocol internal load balancing behavior.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "region", Description: "The Google Cloud Platform region of the backend instance that connected to network load balancing forwarding rule."},
+			{Key: "backend_network_name", Description: "The network name of the NIC of the instance that received the Net LB flow."},
+			{Key: "backend_service_name", Description: "The name of the backend service that handled the connection."},
+			{Key: "forwarding_rule_name", Description: "The name of the forwarding rule."},
+			{Key: "backend_group_name", Description: "The name of the backend group that handled the connection."},
+			{Key: "backend_group_type", Description: "The type of the backend group that handled the connection."},
+			{Key: "backend_group_scope", Description: "The scope (zone or region) of the backend group that handled the connection."},
+			{Key: "backend_subnetwork_name", Description: "The name of the subnetwork of the instance that handled the connection."},
+		},
+	},
+	LoggingBucket: {
+		Type:        LoggingBucket,
+		DisplayName: "Logging Bucket",
+		Description: "An export bucket in Cloud Logging.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "bucket_id", Description: "The name of the log bucket."},
+			{Key: "location", Description: "The location of the log bucket."},
+			{Key: "source_resource_container", Description: "The source resource container (e.g. project, folder, organization) of the log entry that is destined for the log bucket. The format is \"projects/project_id\""},
+			{Key: "monitored_resource_type", Description: "The type field of the monitored resource in the log entry that is destined for the log bucket."},
+		},
+	},
+	LoggingExclusion: {
+		Type:        LoggingExclusion,
+		DisplayName: "Log Exclusion",
+		Description: "An exclusion in Cloud Logging.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "name", Description: "The unique name of the exclusion."},
+		},
+	},
+	LoggingLog: {
+		Type:        LoggingLog,
+		DisplayName: "Log stream",
+		Description: "A Google Cloud Logging log.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "name", Description: "Unique identifier of the log."},
+		},
+	},
+	LoggingSink: {
+		Type:        LoggingSink,
+		DisplayName: "Logging export sink",
+		Description: "An export sink in Cloud Logging.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "name", Description: "The unique name of the sink."},
+			{Key: "destination", Description: "The destination of the sink."},
+		},
+	},
+	ManagedService: {
+		Type:        ManagedService,
+		DisplayName: "Managed Service",
+		Description: "A service managed by Google Service Management.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "service_name", Description: "The name of the service."},
+			{Key: "producer_project_id", Description: "The id of the project which produces and owns this service."},
+		},
+	},
+	Mesh: {
+		Type:        Mesh,
+		DisplayName: "Mesh",
+		Description: "A mesh serves as the \"key\" to deliver configuration to data plane proxy instances.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The location of the control plane"},
+			{Key: "mesh", Description: "The name of the mesh"},
+		},
+	},
+	MetastoreService: {
+		Type:        MetastoreService,
+		DisplayName: "Dataproc Metastore Service",
+		Description: "A Dataproc Metastore Service.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The ID of the customer project."},
+			{Key: "location", Description: "The region that the service is hosted in."},
+			{Key: "service_id", Description: "The service ID."},
+		},
+	},
+	Metric: {
+		Type:        Metric,
+		DisplayName: "Metric Type",
+		Description: "A Stackdriver Monitoring metric type.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "name", Description: "The name of the metric type, such as \"logging.googleapis.com/my-metric-name\"."},
+		},
+	},
+	MLJob: {
+		Type:        MLJob,
+		DisplayName: "Cloud ML Job",
+		Description: "A Cloud Machine Learning job.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "job_id", Description: "The job identifier."},
+			{Key: "task_name", Description: "The task name."},
+		},
+	},
+	NATGateway: {
+		Type:        NATGateway,
+		DisplayName: "Cloud NAT Gateway",
+		Description: "A Cloud NAT Gateway.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "region", Description: "The region where the NAT gateway is located."},
+			{Key: "router_id", Description: "Identifier of the router under which the NAT gateway is defined."},
+			{Key: "gateway_name", Description: "The name of the NAT gateway."},
+		},
+	},
+	NetworkSecurityPolicy: {
+		Type:        NetworkSecurityPolicy,
+		DisplayName: "Network Security Policy",
+		Description: "A network security policy.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "policy_name", Description: "The unique user provided name of the security policy."},
+		},
+	},
+	NetworkingLocation: {
+		Type:        NetworkingLocation,
+		DisplayName: "GCP Location",
+		Description: "A GCP location: a specific zone or region, or \"global\".",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "Name of a GCP zone/region, or \"global\"."},
+		},
+	},
+	Organization: {
+		Type:        Organization,
+		DisplayName: "Google Organization",
+		Description: "A Google Cloud Platform organization.",
+		Labels: []LabelDescriptor{
+			{Key: "organization_id", Description: "Numeric id of the organization."},
+		},
+	},
+	Project: {
+		Type:        Project,
+		DisplayName: "Google Project",
+		Description: "A Google project.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource (e.g., my-project)."},
+		},
+	},
+	PubSubSnapshot: {
+		Type:        PubSubSnapshot,
+		DisplayName: "Cloud Pub/Sub Snapshot",
+		Description: "A snapshot in Google Cloud Pub/Sub.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "snapshot_id", Description: "The identifier of the snapshot, such as \"my-snapshot\"."},
+		},
+	},
+	PubSubSubscription: {
+		Type:        PubSubSubscription,
+		DisplayName: "Cloud Pub/Sub Subscription",
+		Description: "A subscription in Google Cloud Pub/Sub.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "subscription_id", Description: "The identifier of the subscription, such as \"my-subscription\"."},
+		},
+	},
+	PubSubTopic: {
+		Type:        PubSubTopic,
+		DisplayName: "Cloud Pub/Sub Topic",
+		Description: "A topic in Google Cloud Pub/Sub.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "topic_id", Description: "The identifier of the topic, such as \"my-topic\"."},
+		},
+	},
+	RecaptchaEnterpriseKey: {
+		Type:        RecaptchaEnterpriseKey,
+		DisplayName: "reCAPTCHA Key",
+		Description: "Monitoring resource for reCAPTCHA Key.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The ID of the GCP project associated with this reCAPTCHA Key."},
+			{Key: "location", Description: "Location where the reCAPTCHA Key is provisioned."},
+			{Key: "key_id", Description: "The ID for this Key."},
+		},
+	},
+	Recommender: {
+		Type:        Recommender,
+		DisplayName: "Recommender",
+		Description: "A Recommender represents a grouping of similar recommendations.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "recommender_id", Description: "The name of the recommender."},
+			{Key: "location", Description: "The location of the recommendation."},
+		},
+	},
+	RecommenderInsightType: {
+		Type:        RecommenderInsightType,
+		DisplayName: "InsightType",
+		Description: "An InsightType represents a grouping of similar insights.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "insight_type_id", Description: "The resource ID of the insight type."},
+			{Key: "location", Description: "The location of the insight."},
+		},
+	},
+	RedisInstance: {
+		Type:        RedisInstance,
+		DisplayName: "Cloud Memorystore Redis Instance",
+		Description: "A Redis instance hosted on Google Cloud Memorystore.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "region", Description: "The Google Cloud region in which the managed instance is running."},
+			{Key: "instance_id", Description: "The ID of the managed instance."},
+			{Key: "node_id", Description: "The ID of a Redis node within the managed instance."},
+		},
+	},
+	ReportedErrors: {
+		Type:        ReportedErrors,
+		DisplayName: "Reported Errors",
+		Description: "Error data and metadata managed by Stackdriver Error Reporting",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+		},
+	},
+	SecretManagerSecret: {
+		Type:        SecretManagerSecret,
+		DisplayName: "Secret Manager Secret",
+		Description: "A logical secret whose value and versions can be accessed.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP project associated with this resource."},
+			{Key: "location", Description: "Location of secret metadata. Always global."},
+			{Key: "secret_id", Description: "The name given to this secret."},
+		},
+	},
+	ServiceAccount: {
+		Type:        ServiceAccount,
+		DisplayName: "Service Account",
+		Description: "A service account.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource (e.g., my-project)."},
+			{Key: "email_id", Description: "The service account email id, e.g. \"account123@proj123.iam.gserviceaccount.com\"."},
+			{Key: "unique_id", Description: "The unique id of the service account, e.g. \"113948692397867021414\"."},
+		},
+	},
+	ServiceConfig: {
+		Type:        ServiceConfig,
+		DisplayName: "Service Configuration",
+		Description: "A specific service configuration.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "service_name", Description: "The name of the service."},
+			{Key: "service_config_id", Description: "The id of the service configuration."},
+		},
+	},
+	ServiceRollout: {
+		Type:        ServiceRollout,
+		DisplayName: "Service Rollout",
+		Description: "A resource type used to describe how a service configuration is deployed to backend systems.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "service_name", Description: "The name of the service."},
+			{Key: "rollout_id", Description: "The id of the service rollout."},
+		},
+	},
+	ServiceDirectoryNamespace: {
+		Type:        ServiceDirectoryNamespace,
+		DisplayName: "Service Directory Namespace",
+		Description: "A namespace in the Service Directory service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The cloud region of the Service Directory namespace."},
+			{Key: "namespace_name", Description: "The name of the Service Directory namespace."},
+		},
+	},
+	ServiceUsageService: {
+		Type:        ServiceUsageService,
+		DisplayName: "Service",
+		Description: "A service activated or deactivated by a consumer project.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "service_name", Description: "The name of the service."},
+		},
+	},
+	ServiceUserService: {
+		Type:        ServiceUserService,
+		DisplayName: "Service",
+		Description: "A service activated or deactivated by a consumer project.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "service_name", Description: "The name of the service."},
+		},
+	},
+	SpannerInstance: {
+		Type:        SpannerInstance,
+		DisplayName: "Cloud Spanner Instance",
+		Description: "A Cloud Spanner instance.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "instance_id", Description: "An immutable identifier for an instance."},
+			{Key: "location", Description: "Cloud Spanner region."},
+			{Key: "instance_config", Description: "Instance config for the instance."},
+		},
+	},
+	StorageTransferJob: {
+		Type:        StorageTransferJob,
+		DisplayName: "Cloud Storage Transfer Job",
+		Description: "A Google Cloud storage transfer job.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "job_id", Description: "A unique name of the storage transfer job."},
+		},
+	},
+	TCPSSLProxyRule: {
+		Type:        TCPSSLProxyRule,
+		DisplayName: "Google Cloud TCP/SSL Proxy Rule",
+		Description: "A set of definitions for TCP/SSL proxy behavior.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "region", Description: "The region on which TCP/SSL proxy is applied, such as 'global' or 'us-central1'. Various other objects are defined per that locality."},
+			{Key: "backend_target_name", Description: "The name of the backend target ('backend service', equivalent to 'proxy name')."},
+			{Key: "backend_target_type", Description: "The type of the backend target. Can only be 'BACKEND_SERVICE' currently."},
+			{Key: "forwarding_rule_name", Description: "The name of the forwarding rule."},
+			{Key: "target_proxy_name", Description: "The name of the target TCP/SSL proxy."},
+			{Key: "backend_name", Description: "The name of the backend group."},
+			{Key: "backend_type", Description: "The type of the backend group. Can be 'INSTANCE_GROUP' or 'NETWORK_ENDPOINT_GROUP'."},
+			{Key: "backend_scope", Description: "The scope (zone or region) of the backend group."},
+			{Key: "backend_scope_type", Description: "The type of the scope of the backend group. Can be either 'ZONE' or 'REGION'."},
+		},
+	},
+	TestServiceMatrix: {
+		Type:        TestServiceMatrix,
+		DisplayName: "Test Matrix",
+		Description: "A Test Matrix in the Google Cloud Test Lab service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "matrix_id", Description: "Unique identifier of the matrix."},
+		},
+	},
+	ThreatDetector: {
+		Type:        ThreatDetector,
+		DisplayName: "Threat Detector",
+		Description: "A detector in the Threat Detection service.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "detector_name", Description: "The specific detector that triggered the alert."},
+		},
+	},
+	UptimeURL: {
+		Type:        UptimeURL,
+		DisplayName: "Uptime Check URL",
+		Description: "An Uptime Monitoring check against a custom URL.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "host", Description: "The hostname or IP address of the check."},
+		},
+	},
+	VMMigrationMigratingVM: {
+		Type:        VMMigrationMigratingVM,
+		DisplayName: "Migrate to Virtual Machines Migrating VM",
+		Description: "A Migrate to Virtual Machines Migrating VM.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP project associated with this VM resource."},
+			{Key: "location", Description: "The GCP location where the VM resource resides."},
+			{Key: "source", Description: "The source where the VM resource resides."},
+			{Key: "vm", Description: "The VM ID."},
+		},
+	},
+	VMMigrationSource: {
+		Type:        VMMigrationSource,
+		DisplayName: "Migrate to Virtual Machines Source",
+		Description: "A Migrate to Virtual Machines Source.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP project associated with this source resource."},
+			{Key: "location", Description: "The GCP location where the source resource resides."},
+			{Key: "source", Description: "The source ID."},
+		},
+	},
+	VPCAccessConnector: {
+		Type:        VPCAccessConnector,
+		DisplayName: "VPC Access Connector",
+		Description: "A connector that can communicate with devices within a VPC.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "location", Description: "The region the connector is located in."},
+			{Key: "connector_name", Description: "The name of the connector."},
+		},
+	},
+	VPNGateway: {
+		Type:        VPNGateway,
+		DisplayName: "Cloud VPN Gateway",
+		Description: "A Cloud VPN gateway.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "gateway_id", Description: "The VPN Gateway ID."},
+			{Key: "region", Description: "The region in which the VPN Gateway is running."},
+		},
+	},
+	VPNTunnel: {
+		Type:        VPNTunnel,
+		DisplayName: "Cloud VPN Tunnel",
+		Description: "A Cloud VPN tunnel.",
+		Labels: []LabelDescriptor{
+			{Key: "project_id", Description: "The identifier of the GCP project associated with this resource, such as \"my-project\"."},
+			{Key: "tunnel_id", Description: "The unique numerical identifier of the VPN tunnel."},
+			{Key: "tunnel_name", Description: "The unique user provided name of the VPN tunnel."},
+			{Key: "location", Description: "Location of the Cloud VPN Tunnel."},
+		},
+	},
+	WorkflowsWorkflow: {
+		Type:        WorkflowsWorkflow,
+		DisplayName: "Workflow",
+		Description: "A Workflows specification of steps to execute.",
+		Labels: []LabelDescriptor{
+			{Key: "resource_container", Description: "The identifier of the GCP container associated with the resource."},
+			{Key: "location", Description: "The region in which the workflow is deployed."},
+			{Key: "workflow_id", Description: "The ID of the workflow."},
+		},
+	},
+}
+
+// NewAIPlatformEndpoint returns the new Vertex AI Endpoint MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP Project owning the Endpoint.
+//   - location: The region in which the service is running.
+//   - endpoint_id: The ID of the Endpoint.
+func NewAIPlatformEndpoint(resourceContainer, location, endpointID string) (*MonitoredResource, error) {
+	return New(AIPlatformEndpoint, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"endpoint_id":        endpointID,
+	})
+}
+
+// NewAIPlatformFeaturestore returns the new Vertex AI Feature Store MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP Project owning the Featurestore.
+//   - location: The region in which the service is running.
+//   - featurestore_id: The ID of the Featurestore.
+func NewAIPlatformFeaturestore(resourceContainer, location, featurestoreID string) (*MonitoredResource, error) {
+	return New(AIPlatformFeaturestore, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"featurestore_id":    featurestoreID,
+	})
+}
+
+// NewAIPlatformIndexEndpoint returns the new Matching Engine Index Endpoint MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP Project owning the Index.
+//   - location: The region in which the service is running.
+//   - index_endpoint_id: The ID of the index endpoint.
+func NewAIPlatformIndexEndpoint(resourceContainer, location, indexEndpointID string) (*MonitoredResource, error) {
+	return New(AIPlatformIndexEndpoint, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"index_endpoint_id":  indexEndpointID,
+	})
+}
+
+// NewAIPlatformPipelineJob returns the new Vertex Pipelines Job MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The region in which the service is running.
+//   - pipeline_job_id: The ID of the PipelineJob.
+func NewAIPlatformPipelineJob(resourceContainer, location, pipelineJobID string) (*MonitoredResource, error) {
+	return New(AIPlatformPipelineJob, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"pipeline_job_id":    pipelineJobID,
+	})
+}
+
+// NewAlloyDBInstance returns the new AlloyDB instance MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP project associated with this resource.
+//   - location: The Google Cloud region in which the AlloyDB instance is running.
+//   - cluster_id: AlloyDB cluster identifier.
+//   - instance_id: AlloyDB instance identifier.
+func NewAlloyDBInstance(resourceContainer, location, clusterID, instanceID string) (*MonitoredResource, error) {
+	return New(AlloyDBInstance, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"cluster_id":         clusterID,
+		"instance_id":        instanceID,
+	})
+}
+
+// NewAPI returns the new Produced API MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - service: The API service name, such as "cloudsql.googleapis.com".
+//   - method: The API method, such as "disks.list".
+//   - version: The API version, such as "v1".
+//   - location: The service specific notion of location. This can be the name of a zone, region, or "global".
+func NewAPI(projectID, service, method, version, location string) (*MonitoredResource, error) {
+	return New(API, Label{
+		"project_id": projectID,
+		"service":    service,
+		"method":     method,
+		"version":    version,
+		"location":   location,
+	})
+}
+
+// NewAPIGatewayGateway returns the new API Gateway MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP Project owning the Gateway.
+//   - location: The region in which the Gateway is running.
+//   - gateway_id: The ID of the Gateway.
+func NewAPIGatewayGateway(resourceContainer, location, gatewayID string) (*MonitoredResource, error) {
+	return New(APIGatewayGateway, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"gateway_id":         gatewayID,
+	})
+}
+
+// NewApigeeEnvironment returns the new Apigee environment MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The GCP project ID that writes to this monitored resource.
+//   - org: An organization is a container for all the objects in an Apigee account.
+//   - env: An environment is a runtime execution context for the proxies in an organization.
+//   - location: Location where the Apigee infrastructure is provisioned.
+func NewApigeeEnvironment(resourceContainer, org, env, location string) (*MonitoredResource, error) {
+	return New(ApigeeEnvironment, Label{
+		"resource_container": resourceContainer,
+		"org":                org,
+		"env":                env,
+		"location":           location,
+	})
+}
+
+// NewAppScriptFunction returns the new Apps Script Function MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - invocation_type: The invocation type.
+//   - function_name: The function name.
+func NewAppScriptFunction(projectID, invocationType, functionName string) (*MonitoredResource, error) {
+	return New(AppScriptFunction, Label{
+		"project_id":      projectID,
+		"invocation_type": invocationType,
+		"function_name":   functionName,
+	})
+}
+
+// NewAssistantAction returns the new Google Assistant Action MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - version_id: Stringified version ID of the assistant agent.
+//   - action_id: Action ID of the assistant agent.
+func NewAssistantAction(projectID, versionID, actionID string) (*MonitoredResource, error) {
+	return New(AssistantAction, Label{
+		"project_id": projectID,
+		"version_id": versionID,
+		"action_id":  actionID,
+	})
+}
+
+// NewAuditedResource returns the new Audited Resource MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - service: The name of the API service generating the audit log.
+//   - method: The name of the API method generating the audit log.
+func NewAuditedResource(projectID, service, method string) (*MonitoredResource, error) {
+	return New(AuditedResource, Label{
+		"project_id": projectID,
+		"service":    service,
+		"method":     method,
+	})
+}
+
+// NewAutoscaler returns the new Autoscaler MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The zone or region for the autoscaler.
+//   - autoscaler_id: The identifier for the autoscaler.
+//   - autoscaler_name: The name of the autoscaler.
+//   - instance_group_manager_id: The identifier for the managed instance group scaled by the given autoscaler.
+//   - instance_group_manager_name: The name of the managed instance group scaled by the givenautoscaler.
+func NewAutoscaler(projectID, location, autoscalerID, autoscalerName, instanceGroupManagerID, instanceGroupManagerName string) (*MonitoredResource, error) {
+	return New(Autoscaler, Label{
+		"project_id":                  projectID,
+		"location":                    location,
+		"autoscaler_id":               autoscalerID,
+		"autoscaler_name":             autoscalerName,
+		"instance_group_manager_id":   instanceGroupManagerID,
+		"instance_group_manager_name": instanceGroupManagerName,
+	})
+}
+
+// NewAWSALBLoadBalancer returns the new Amazon ALB Load Balancer MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - name: The name of the load balancer.
+//   - region: The AWS region for the load balancer. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the load balancer.
+func NewAWSALBLoadBalancer(projectID, name, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSALBLoadBalancer, Label{
+		"project_id":  projectID,
+		"name":        name,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSCloudFrontDistribution returns the new Amazon CloudFront CDN MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - distribution_id: The CloudFront distribution identifier assigned by AWS.
+//   - region: The AWS region for the CloudFront distribution. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the CDN.
+func NewAWSCloudFrontDistribution(projectID, distributionID, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSCloudFrontDistribution, Label{
+		"project_id":      projectID,
+		"distribution_id": distributionID,
+		"region":          region,
+		"aws_account":     awsAccount,
+	})
+}
+
+// NewAWSDynamoDBTable returns the new Amazon DynamoDB Table MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - table: The table name.
+//   - region: The AWS region for the table. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the table.
+func NewAWSDynamoDBTable(projectID, table, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSDynamoDBTable, Label{
+		"project_id":  projectID,
+		"table":       table,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSEBSVolume returns the new Amazon EBS Volume MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - volume_id: The EBS volume identifier assigned by AWS.
+//   - region: The AWS region for the volume. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the volume.
+func NewAWSEBSVolume(projectID, volumeID, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSEBSVolume, Label{
+		"project_id":  projectID,
+		"volume_id":   volumeID,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSEC2Instance returns the new Amazon EC2 Instance MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - instance_id: The VM instance identifier assigned by AWS.
+//   - aws_account: The AWS account number under which the VM is running.
+//   - region: The AWS region in which the VM is running. Supported AWS region values are listed by service at http://docs.aws.amazon.com/general/latest/gr/rande.html. The value supplied for this label must be prefixed with 'aws:' (for example, 'aws:us-east-1' is a valid value while 'us-east-1' is not).
+func NewAWSEC2Instance(projectID, instanceID, awsAccount, region string) (*MonitoredResource, error) {
+	return New(AWSEC2Instance, Label{
+		"project_id":  projectID,
+		"instance_id": instanceID,
+		"aws_account": awsAccount,
+		"region":      region,
+	})
+}
+
+// NewAWSElastiCacheCluster returns the new Amazon Elasticache Cluster MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - cluster_id: The cluster identifier.
+//   - region: The AWS region for the cluster. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the cluster.
+func NewAWSElastiCacheCluster(projectID, clusterID, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSElastiCacheCluster, Label{
+		"project_id":  projectID,
+		"cluster_id":  clusterID,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSELBLoadBalancer returns the new Amazon Elastic Load Balancer MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - region: The AWS region for the load balancer. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - name: The name of the load balancer.
+//   - aws_account: The AWS account number for the load balancer.
+func NewAWSELBLoadBalancer(projectID, region, name, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSELBLoadBalancer, Label{
+		"project_id":  projectID,
+		"region":      region,
+		"name":        name,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSEMRCluster returns the new Amazon EMR Cluster MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - cluster_id: The cluster identifier.
+//   - region: The AWS region for the cluster. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the cluster.
+func NewAWSEMRCluster(projectID, clusterID, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSEMRCluster, Label{
+		"project_id":  projectID,
+		"cluster_id":  clusterID,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSKinesisStream returns the new Amazon Kinesis Stream MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - stream_name: The stream name.
+//   - region: The AWS region for the stream. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the stream.
+func NewAWSKinesisStream(projectID, streamName, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSKinesisStream, Label{
+		"project_id":  projectID,
+		"stream_name": streamName,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSLambdaFunction returns the new Amazon Lambda Function MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - function_name: The function name.
+//   - region: The AWS region for the function. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the function.
+func NewAWSLambdaFunction(projectID, functionName, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSLambdaFunction, Label{
+		"project_id":    projectID,
+		"function_name": functionName,
+		"region":        region,
+		"aws_account":   awsAccount,
+	})
+}
+
+// NewAWSRDSDatabase returns the new Amazon RDS Database MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - name: The database name.
+//   - region: The AWS region for the database. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the database.
+func NewAWSRDSDatabase(projectID, name, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSRDSDatabase, Label{
+		"project_id":  projectID,
+		"name":        name,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSRedshiftCluster returns the new Amazon Redshift Cluster MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - cluster_identifier: The cluster name.
+//   - region: The AWS region for the cluster. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the cluster.
+func NewAWSRedshiftCluster(projectID, clusterIdentifier, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSRedshiftCluster, Label{
+		"project_id":         projectID,
+		"cluster_identifier": clusterIdentifier,
+		"region":             region,
+		"aws_account":        awsAccount,
+	})
+}
+
+// NewAWSS3Bucket returns the new Amazon S3 Bucket MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - bucket_name: The bucket name.
+//   - region: The AWS region for the bucket. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the bucket.
+func NewAWSS3Bucket(projectID, bucketName, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSS3Bucket, Label{
+		"project_id":  projectID,
+		"bucket_name": bucketName,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSSES returns the new Amazon SES Region MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - region: The AWS region. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the SES region.
+func NewAWSSES(projectID, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSSES, Label{
+		"project_id":  projectID,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSSNSTopic returns the new Amazon SNS Topic MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - topic: The topic name.
+//   - region: The AWS region for the topic. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the topic.
+func NewAWSSNSTopic(projectID, topic, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSSNSTopic, Label{
+		"project_id":  projectID,
+		"topic":       topic,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewAWSSQSQueue returns the new Amazon SQS Queue MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project under which data is stored for the AWS account specified in the aws_account label, such as "my-project".
+//   - queue: The queue name.
+//   - region: The AWS region for the queue. The format of this field is "aws:{region}", where supported values for {region} are listed at http://docs.aws.amazon.com/general/latest/gr/rande.html.
+//   - aws_account: The AWS account number for the queue.
+func NewAWSSQSQueue(projectID, queue, region, awsAccount string) (*MonitoredResource, error) {
+	return New(AWSSQSQueue, Label{
+		"project_id":  projectID,
+		"queue":       queue,
+		"region":      region,
+		"aws_account": awsAccount,
+	})
+}
+
+// NewBigQueryBIEngineModel returns the new BigQuery BI Engine Model MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Google Cloud location of the resource.
+//   - model_id: The identifier of the BI model.
+func NewBigQueryBIEngineModel(projectID, location, modelID string) (*MonitoredResource, error) {
+	return New(BigQueryBIEngineModel, Label{
+		"project_id": projectID,
+		"location":   location,
+		"model_id":   modelID,
+	})
+}
+
+// NewBigQueryDataset returns the new BigQuery Dataset MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - dataset_id: The name of the BigQuery dataset.
+func NewBigQueryDataset(projectID, datasetID string) (*MonitoredResource, error) {
+	return New(BigQueryDataset, Label{
+		"project_id": projectID,
+		"dataset_id": datasetID,
+	})
+}
+
+// NewBigQueryDTSConfig returns the new BigQuery DTS Config MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Google Cloud location of the resource
+//   - config_id: The id of the DTS configuration.
+func NewBigQueryDTSConfig(projectID, location, configID string) (*MonitoredResource, error) {
+	return New(BigQueryDTSConfig, Label{
+		"project_id": projectID,
+		"location":   location,
+		"config_id":  configID,
+	})
+}
+
+// NewBigQueryDTSRun returns the new BigQuery DTS Run MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Google Cloud location of the resource
+//   - config_id: The name of the DTS config that created the run.
+//   - run_id: The unique resource name of the BigQuery DTS run.
+func NewBigQueryDTSRun(projectID, location, configID, runID string) (*MonitoredResource, error) {
+	return New(BigQueryDTSRun, Label{
+		"project_id": projectID,
+		"location":   location,
+		"config_id":  configID,
+		"run_id":     runID,
+	})
+}
+
+// NewBigQueryProject returns the new BigQuery Project MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: Location of the resource.
+func NewBigQueryProject(projectID, location string) (*MonitoredResource, error) {
+	return New(BigQueryProject, Label{
+		"project_id": projectID,
+		"location":   location,
+	})
+}
+
+// NewBigQueryResource returns the new BigQuery MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+func NewBigQueryResource(projectID string) (*MonitoredResource, error) {
+	return New(BigQueryResource, Label{
+		"project_id": projectID,
+	})
+}
+
+// NewBigQueryTable returns the new BigQuery Table MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - dataset_id: The name of the BigQuery dataset.
+//   - table_id: The name of the BigQuery table.
+func NewBigQueryTable(projectID, datasetID, tableID string) (*MonitoredResource, error) {
+	return New(BigQueryTable, Label{
+		"project_id": projectID,
+		"dataset_id": datasetID,
+		"table_id":   tableID,
+	})
+}
+
+// NewBillingAccount returns the new Cloud Billing Account MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - account_id: The unique id of the billing account.
+func NewBillingAccount(projectID, accountID string) (*MonitoredResource, error) {
+	return New(BillingAccount, Label{
+		"project_id": projectID,
+		"account_id": accountID,
+	})
+}
+
+// NewBuild returns the new Cloud Build MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - build_id: The unique id of the build.
+//   - build_trigger_id: The unique id of the build trigger.
+func NewBuild(projectID, buildID, buildTriggerID string) (*MonitoredResource, error) {
+	return New(Build, Label{
+		"project_id":       projectID,
+		"build_id":         buildID,
+		"build_trigger_id": buildTriggerID,
+	})
+}
+
+// NewCertificateManagerProject returns the new Certificate Manager project MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The GCP container associated with the resource.
+//   - location: GCP location.
+func NewCertificateManagerProject(resourceContainer, location string) (*MonitoredResource, error) {
+	return New(CertificateManagerProject, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+	})
+}
+
+// NewClientAuthConfigBrand returns the new OAuth2 Brand MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - brand_id: The unique id of the brand.
+func NewClientAuthConfigBrand(projectID, brandID string) (*MonitoredResource, error) {
+	return New(ClientAuthConfigBrand, Label{
+		"project_id": projectID,
+		"brand_id":   brandID,
+	})
+}
+
+// NewClientAuthConfigClient returns the new OAuth2 Client MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - client_id: The unique id of the client.
+func NewClientAuthConfigClient(projectID, clientID string) (*MonitoredResource, error) {
+	return New(ClientAuthConfigClient, Label{
+		"project_id": projectID,
+		"client_id":  clientID,
+	})
+}
+
+// NewCloudComposerEnvironment returns the new Cloud Composer Environment MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Cloud Composer location in which the environment is running.
+//   - environment_name: The user-specified environment name.
+func NewCloudComposerEnvironment(projectID, location, environmentName string) (*MonitoredResource, error) {
+	return New(CloudComposerEnvironment, Label{
+		"project_id":       projectID,
+		"location":         location,
+		"environment_name": environmentName,
+	})
+}
+
+// NewCloudDataprocBatch returns the new Cloud Dataproc Batch MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Cloud Dataproc region to which the batch was submitted.
+//   - batch_id: The user-specified batch id.
+func NewCloudDataprocBatch(projectID, location, batchID string) (*MonitoredResource, error) {
+	return New(CloudDataprocBatch, Label{
+		"project_id": projectID,
+		"location":   location,
+		"batch_id":   batchID,
+	})
+}
+
+// NewCloudDataprocCluster returns the new Cloud Dataproc Cluster MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - cluster_name: The user-specified cluster name.
+//   - cluster_uuid: The generated cluster id.
+//   - region: The Cloud Dataproc region in which the cluster is running.
+func NewCloudDataprocCluster(projectID, clusterName, clusterUUID, region string) (*MonitoredResource, error) {
+	return New(CloudDataprocCluster, Label{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+		"cluster_uuid": clusterUUID,
+		"region":       region,
+	})
+}
+
+// NewCloudDataprocJob returns the new Cloud Dataproc Job MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - region: The Cloud Dataproc region to which the job was submitted.
+//   - job_id: The user-specified job id.
+//   - job_uuid: The generated job uuid.
+func NewCloudDataprocJob(projectID, region, jobID, jobUUID string) (*MonitoredResource, error) {
+	return New(CloudDataprocJob, Label{
+		"project_id": projectID,
+		"region":     region,
+		"job_id":     jobID,
+		"job_uuid":   jobUUID,
+	})
+}
+
+// NewCloudDebuggerResource returns the new Cloud Debugger MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - app: The application to which the debugger is attached.
+func NewCloudDebuggerResource(projectID, app string) (*MonitoredResource, error) {
+	return New(CloudDebuggerResource, Label{
+		"project_id": projectID,
+		"app":        app,
+	})
+}
+
+// NewCloudFunction returns the new Cloud Function MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - function_name: The short function name.
+//   - region: The region in which the function is running.
+func NewCloudFunction(projectID, functionName, region string) (*MonitoredResource, error) {
+	return New(CloudFunction, Label{
+		"project_id":    projectID,
+		"function_name": functionName,
+		"region":        region,
+	})
+}
+
+// NewCloudRunJob returns the new Cloud Run Job MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - job_name: Name of the monitored job.
+//   - location: Region where the job exists.
+func NewCloudRunJob(projectID, jobName, location string) (*MonitoredResource, error) {
+	return New(CloudRunJob, Label{
+		"project_id": projectID,
+		"job_name":   jobName,
+		"location":   location,
+	})
+}
+
+// NewCloudRunRevision returns the new Cloud Run Revision MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - service_name: Name of the service.
+//   - revision_name: Name of the monitored revision.
+//   - location: Region where the service is running.
+//   - configuration_name: Name of the configuration which created the monitored revision.
+func NewCloudRunRevision(projectID, serviceName, revisionName, location, configurationName string) (*MonitoredResource, error) {
+	return New(CloudRunRevision, Label{
+		"project_id":         projectID,
+		"service_name":       serviceName,
+		"revision_name":      revisionName,
+		"location":           location,
+		"configuration_name": configurationName,
+	})
+}
+
+// NewCloudSchedulerJob returns the new Cloud Scheduler Job MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The region of the job.
+//   - job_id: Identifier of the job.
+func NewCloudSchedulerJob(projectID, location, jobID string) (*MonitoredResource, error) {
+	return New(CloudSchedulerJob, Label{
+		"project_id": projectID,
+		"location":   location,
+		"job_id":     jobID,
+	})
+}
+
+// NewCloudTasksQueue returns the new Cloud Tasks Queue MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - queue_id: The name of the queue.
+//   - target_type: The target type the queue is dispatching to.
+//   - location: The zone or region where the application is running.
+func NewCloudTasksQueue(projectID, queueID, targetType, location string) (*MonitoredResource, error) {
+	return New(CloudTasksQueue, Label{
+		"project_id":  projectID,
+		"queue_id":    queueID,
+		"target_type": targetType,
+		"location":    location,
+	})
+}
+
+// NewCloudDeployDeliveryPipeline returns the new Cloud Deploy Delivery Pipeline MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the Google Cloud project associated with this resource.
+//   - location: The Google Cloud location where the resource resides.
+//   - pipeline_id: ID of the delivery pipeline resource.
+func NewCloudDeployDeliveryPipeline(resourceContainer, location, pipelineID string) (*MonitoredResource, error) {
+	return New(CloudDeployDeliveryPipeline, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"pipeline_id":        pipelineID,
+	})
+}
+
+// NewCloudIoTDevice returns the new Cloud IoT Device MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - device_num_id: The unique numeric identifier of the device.
+//   - device_registry_id: The user-defined string identifier of the device registry.
+//   - location: The cloud region of the device registry.
+func NewCloudIoTDevice(projectID, deviceNumID, deviceRegistryID, location string) (*MonitoredResource, error) {
+	return New(CloudIoTDevice, Label{
+		"project_id":         projectID,
+		"device_num_id":      deviceNumID,
+		"device_registry_id": deviceRegistryID,
+		"location":           location,
+	})
+}
+
+// NewCloudIoTDeviceRegistry returns the new Cloud IoT Registry MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - device_registry_id: The user-defined string identifier of the device registry.
+//   - location: The cloud region of the device registry.
+func NewCloudIoTDeviceRegistry(projectID, deviceRegistryID, location string) (*MonitoredResource, error) {
+	return New(CloudIoTDeviceRegistry, Label{
+		"project_id":         projectID,
+		"device_registry_id": deviceRegistryID,
+		"location":           location,
+	})
+}
+
+// NewCloudKMSCryptoKey returns the new Cloud KMS CryptoKey MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The region Crypto Key belongs to.
+//   - key_ring_id: Key Ring the Crypto Key belongs to.
+//   - crypto_key_id: Crypto Key Identifier.
+func NewCloudKMSCryptoKey(projectID, location, keyRingID, cryptoKeyID string) (*MonitoredResource, error) {
+	return New(CloudKMSCryptoKey, Label{
+		"project_id":    projectID,
+		"location":      location,
+		"key_ring_id":   keyRingID,
+		"crypto_key_id": cryptoKeyID,
+	})
+}
+
+// NewCloudKMSCryptoKeyVersion returns the new Cloud KMS CryptoKeyVersion MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The region Crypto Key Version belongs to.
+//   - key_ring_id: Key Ring the Crypto Key Version belongs to.
+//   - crypto_key_id: Crypto Key the Crypto Key Version belongs to.
+//   - crypto_key_version_id: Crypto Key Version Identifier.
+func NewCloudKMSCryptoKeyVersion(projectID, location, keyRingID, cryptoKeyID, cryptoKeyVersionID string) (*MonitoredResource, error) {
+	return New(CloudKMSCryptoKeyVersion, Label{
+		"project_id":            projectID,
+		"location":              location,
+		"key_ring_id":           keyRingID,
+		"crypto_key_id":         cryptoKeyID,
+		"crypto_key_version_id": cryptoKeyVersionID,
+	})
+}
+
+// NewCloudKMSKeyRing returns the new Cloud KMS Key Ring MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The region Key Ring belongs to.
+//   - key_ring_id: Key Ring Identifier.
+func NewCloudKMSKeyRing(projectID, location, keyRingID string) (*MonitoredResource, error) {
+	return New(CloudKMSKeyRing, Label{
+		"project_id":  projectID,
+		"location":    location,
+		"key_ring_id": keyRingID,
+	})
+}
+
+// NewCloudMLModelVersion returns the new Cloud ML Model Version MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - model_id: An immutable identifier for a model.
+//   - version_id: An immutable identifier for a version.
+//   - region: Cloud ML region.
+func NewCloudMLModelVersion(projectID, modelID, versionID, region string) (*MonitoredResource, error) {
+	return New(CloudMLModelVersion, Label{
+		"project_id": projectID,
+		"model_id":   modelID,
+		"version_id": versionID,
+		"region":     region,
+	})
+}
+
+// NewCloudSQLDatabase returns the new Cloud SQL Database MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - database_id: The ID of the database.
+//   - region: The Google Cloud SQL region in which the database is running.
+func NewCloudSQLDatabase(projectID, databaseID, region string) (*MonitoredResource, error) {
+	return New(CloudSQLDatabase, Label{
+		"project_id":  projectID,
+		"database_id": databaseID,
+		"region":      region,
+	})
+}
+
+// NewNetAppCloudVolume returns the new Monitored Resource for NetApp CVS MonitoredResource.
+//
+// The labels are:
+//   - resource_container: Project information.
+//   - location: Region/Zone information.
+//   - volume_id: ID of the volume.
+//   - service_type: Service type of the volume or replication relationship.
+//   - name: Name of the volume or replication relationship.
+func NewNetAppCloudVolume(resourceContainer, location, volumeID, serviceType, name string) (*MonitoredResource, error) {
+	return New(NetAppCloudVolume, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"volume_id":          volumeID,
+		"service_type":       serviceType,
+		"name":               name,
+	})
+}
+
+// NewConsumedAPI returns the new Consumed API MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as 'my-project'.
+//   - service: The API service name, such as 'cloudsql.googleapis.com'.
+//   - method: The API method name, such as 'disks.list'.
+//   - version: The API version, such as 'v1'.
+//   - location: The service specific notion of location. This can be a name of a zone or region. If a service does not have any notion of zones then 'global' can be used.
+//   - credential_id: The client credential ID, such as an API key ID or the OAuth client ID.
+func NewConsumedAPI(projectID, service, method, version, location, credentialID string) (*MonitoredResource, error) {
+	return New(ConsumedAPI, Label{
+		"project_id":    projectID,
+		"service":       service,
+		"method":        method,
+		"version":       version,
+		"location":      location,
+		"credential_id": credentialID,
+	})
+}
+
+// NewContainer returns the new GKE Container MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - cluster_name: An immutable name for the cluster the container is running in.
+//   - namespace_id: Immutable ID of the cluster namespace the container is running in.
+//   - instance_id: Immutable ID of the GCE instance the container is running in.
+//   - pod_id: Immutable ID of the pod the container is running in.
+//   - container_name: Immutable name of the container.
+//   - zone: The GCE zone in which the instance is running.
+func NewContainer(projectID, clusterName, namespaceID, instanceID, podID, containerName, zone string) (*MonitoredResource, error) {
+	return New(Container, Label{
+		"project_id":     projectID,
+		"cluster_name":   clusterName,
+		"namespace_id":   namespaceID,
+		"instance_id":    instanceID,
+		"pod_id":         podID,
+		"container_name": containerName,
+		"zone":           zone,
+	})
+}
+
+// NewCSRRepository returns the new Cloud Source Repository MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - name: The name of the repository.
+func NewCSRRepository(projectID, name string) (*MonitoredResource, error) {
+	return New(CSRRepository, Label{
+		"project_id": projectID,
+		"name":       name,
+	})
+}
+
+// NewDataflowStep returns the new Dataflow Step MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - job_id: The ID of the job.
+//   - step_id: The ID of the step.
+//   - job_name: The name of the job.
+//   - region: The region in which the job is running.
+func NewDataflowStep(projectID, jobID, stepID, jobName, region string) (*MonitoredResource, error) {
+	return New(DataflowStep, Label{
+		"project_id": projectID,
+		"job_id":     jobID,
+		"step_id":    stepID,
+		"job_name":   jobName,
+		"region":     region,
+	})
+}
+
+// NewDataMigrationMigrationJob returns the new Database migration service migration job MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The resource container (project ID).
+//   - location: The location.
+//   - migration_job_id: The migration job ID.
+func NewDataMigrationMigrationJob(resourceContainer, location, migrationJobID string) (*MonitoredResource, error) {
+	return New(DataMigrationMigrationJob, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"migration_job_id":   migrationJobID,
+	})
+}
+
+// NewDataplexEnvironment returns the new Cloud Dataplex Environment MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of GCP project associated with this resource.
+//   - location: The GCP region associated with this resource.
+//   - lake_id: The identifier of the Lake resource containing this resource.
+//   - environment_id: The identifier of this Environment resource.
+func NewDataplexEnvironment(resourceContainer, location, lakeID, environmentID string) (*MonitoredResource, error) {
+	return New(DataplexEnvironment, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"lake_id":            lakeID,
+		"environment_id":     environmentID,
+	})
+}
+
+// NewDataplexLake returns the new Cloud Dataplex Lake MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of GCP project associated with this resource.
+//   - location: The GCP region associated with this resource.
+//   - lake_id: The identifier of this Lake resource.
+func NewDataplexLake(resourceContainer, location, lakeID string) (*MonitoredResource, error) {
+	return New(DataplexLake, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"lake_id":            lakeID,
+	})
+}
+
+// NewDataplexTask returns the new Cloud Dataplex Task MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of GCP project associated with this resource.
+//   - location: The GCP region associated with this resource.
+//   - lake_id: The identifier of the Lake resource containing this resource.
+//   - task_id: The identifier of this Task resource.
+func NewDataplexTask(resourceContainer, location, lakeID, taskID string) (*MonitoredResource, error) {
+	return New(DataplexTask, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"lake_id":            lakeID,
+		"task_id":            taskID,
+	})
+}
+
+// NewDataplexZone returns the new Cloud Dataplex Zone MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of GCP project associated with this resource.
+//   - location: The GCP region associated with this resource.
+//   - lake_id: The identifier of the Lake resource containing this resource.
+//   - zone_id: The identifier of this Zone resource.
+func NewDataplexZone(resourceContainer, location, lakeID, zoneID string) (*MonitoredResource, error) {
+	return New(DataplexZone, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"lake_id":            lakeID,
+		"zone_id":            zoneID,
+	})
+}
+
+// NewDataprocCluster returns the new Dataproc Cluster MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - cluster_id: The cluster ID, concatenated from the cluster name and uuid
+//   - zone: The GCE zone in which the instance is running.
+func NewDataprocCluster(projectID, clusterID, zone string) (*MonitoredResource, error) {
+	return New(DataprocCluster, Label{
+		"project_id": projectID,
+		"cluster_id": clusterID,
+		"zone":       zone,
+	})
+}
+
+// NewDatastoreDatabase returns the new Cloud Datastore Database MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - database_id: The unique id of the database.
+func NewDatastoreDatabase(projectID, databaseID string) (*MonitoredResource, error) {
+	return New(DatastoreDatabase, Label{
+		"project_id":  projectID,
+		"database_id": databaseID,
+	})
+}
+
+// NewDatastoreIndex returns the new Cloud Datastore Index MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - database_id: The database the index belongs to.
+//   - index_id: The unique id of the index.
+func NewDatastoreIndex(projectID, databaseID, indexID string) (*MonitoredResource, error) {
+	return New(DatastoreIndex, Label{
+		"project_id":  projectID,
+		"database_id": databaseID,
+		"index_id":    indexID,
+	})
+}
+
+// NewDatastreamStream returns the new Datastream Stream MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The resource container (project ID).
+//   - location: The location.
+//   - stream_id: The stream ID.
+func NewDatastreamStream(resourceContainer, location, streamID string) (*MonitoredResource, error) {
+	return New(DatastreamStream, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"stream_id":          streamID,
+	})
+}
+
+// NewDeployment returns the new Deployment MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - name: Name that uniquely identifies the deployment within a project.
+func NewDeployment(projectID, name string) (*MonitoredResource, error) {
+	return New(Deployment, Label{
+		"project_id": projectID,
+		"name":       name,
+	})
+}
+
+// NewDeploymentManagerManifest returns the new Deployment Manager Manifest MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - manifest_name: Name that uniquely identifies the manifest within a project.
+//   - deployment_name: Name of the deployment.
+func NewDeploymentManagerManifest(projectID, manifestName, deploymentName string) (*MonitoredResource, error) {
+	return New(DeploymentManagerManifest, Label{
+		"project_id":      projectID,
+		"manifest_name":   manifestName,
+		"deployment_name": deploymentName,
+	})
+}
+
+// NewDeploymentManagerOperation returns the new Deployment Manager Operation MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - operation_name: Name that uniquely identifies the operation within a project.
+func NewDeploymentManagerOperation(projectID, operationName string) (*MonitoredResource, error) {
+	return New(DeploymentManagerOperation, Label{
+		"project_id":     projectID,
+		"operation_name": operationName,
+	})
+}
+
+// NewDeploymentManagerResource returns the new Deployment Manager Resource MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - resource_name: Name of the resource, unique within a deployment.
+//   - deployment_name: Name of the deployment.
+func NewDeploymentManagerResource(projectID, resourceName, deploymentName string) (*MonitoredResource, error) {
+	return New(DeploymentManagerResource, Label{
+		"project_id":      projectID,
+		"resource_name":   resourceName,
+		"deployment_name": deploymentName,
+	})
+}
+
+// NewDeploymentManagerType returns the new Deployment Manager Type MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - name: Name that uniquely identifies the type within a project.
+func NewDeploymentManagerType(projectID, name string) (*MonitoredResource, error) {
+	return New(DeploymentManagerType, Label{
+		"project_id": projectID,
+		"name":       name,
+	})
+}
+
+// NewDNSManagedZone returns the new Managed DNS Zone MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - zone_name: The name of the ManagedZone.
+//   - location: The location field is provided for compatibility with other GCP services. Its value is always set to 'global'
+func NewDNSManagedZone(projectID, zoneName, location string) (*MonitoredResource, error) {
+	return New(DNSManagedZone, Label{
+		"project_id": projectID,
+		"zone_name":  zoneName,
+		"location":   location,
+	})
+}
+
+// NewDNSPolicy returns the new Cloud DNS Policy MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - policy_name: The name of the Policy.
+//   - location: The location field is provided for compatibility with other GCP services. Its value is always set to 'global'
+func NewDNSPolicy(projectID, policyName, location string) (*MonitoredResource, error) {
+	return New(DNSPolicy, Label{
+		"project_id":  projectID,
+		"policy_name": policyName,
+		"location":    location,
+	})
+}
+
+// NewDNSQuery returns the new Cloud DNS Query MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - target_name: The DNS name managed by Cloud DNS to be resolved (e.g. the zone name, policy name, internal domain name). External names will have the value "external"
+//   - location: The GCP zone where the DNS request was received (e.g. us-east1, us-west1).
+//   - target_type: The target of the resolution of the DNS query (e.g. public-zone, private-zone, external).
+//   - source_type: Source of the query (e.g. gce-vm, internet).
+func NewDNSQuery(projectID, targetName, location, targetType, sourceType string) (*MonitoredResource, error) {
+	return New(DNSQuery, Label{
+		"project_id":  projectID,
+		"target_name": targetName,
+		"location":    location,
+		"target_type": targetType,
+		"source_type": sourceType,
+	})
+}
+
+// NewFirebaseDomain returns the new Firebase Hosting Site Domain MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as 'my-project'.
+//   - site_name: The name of a Firebase Hosting site, that is the subdomain in .web.app.
+//   - domain_name: The default subdomain (on web.app or firebaseapp.com) or custom domain from which content was served.
+func NewFirebaseDomain(projectID, siteName, domainName string) (*MonitoredResource, error) {
+	return New(FirebaseDomain, Label{
+		"project_id":  projectID,
+		"site_name":   siteName,
+		"domain_name": domainName,
+	})
+}
+
+// NewFirebaseNamespace returns the new Firebase Realtime Database MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - table_name: The name of the database.
+//   - location: The location of the database.
+func NewFirebaseNamespace(projectID, tableName, location string) (*MonitoredResource, error) {
+	return New(FirebaseNamespace, Label{
+		"project_id": projectID,
+		"table_name": tableName,
+		"location":   location,
+	})
+}
+
+// NewFleetEngineFleet returns the new Fleet Engine On Demand Rides and Deliveries MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP container associated with Fleet.
+//   - location: The region in which the Fleet Engine instance is running.
+func NewFleetEngineFleet(resourceContainer, location string) (*MonitoredResource, error) {
+	return New(FleetEngineFleet, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+	})
+}
+
+// NewFolder returns the new Google Folder MonitoredResource.
+//
+// The labels are:
+//   - folder_id: Numeric id of the folder.
+func NewFolder(folderID string) (*MonitoredResource, error) {
+	return New(Folder, Label{
+		"folder_id": folderID,
+	})
+}
+
+// NewGAEApp returns the new GAE Application MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - module_id: The service/module name.
+//   - version_id: The version name.
+//   - zone: The GAE zone where the application is running.
+func NewGAEApp(projectID, moduleID, versionID, zone string) (*MonitoredResource, error) {
+	return New(GAEApp, Label{
+		"project_id": projectID,
+		"module_id":  moduleID,
+		"version_id": versionID,
+		"zone":       zone,
+	})
+}
+
+// NewGatewayScope returns the new Gateway Scope MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The location of the control plane
+//   - scope: The name of the gateway_scope
+func NewGatewayScope(projectID, location, scope string) (*MonitoredResource, error) {
+	return New(GatewayScope, Label{
+		"project_id": projectID,
+		"location":   location,
+		"scope":      scope,
+	})
+}
+
+// NewGCEAutoscaler returns the new GCE Autoscaler MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - autoscaler_id: Unique identifier of the autoscaler.
+//   - location: GCE zone or region where the autoscaler is running.
+func NewGCEAutoscaler(projectID, autoscalerID, location string) (*MonitoredResource, error) {
+	return New(GCEAutoscaler, Label{
+		"project_id":    projectID,
+		"autoscaler_id": autoscalerID,
+		"location":      location,
+	})
+}
+
+// NewGCEBackendBucket returns the new GCE Backend Bucket MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - backend_bucket_id: Unique identifier of the backend bucket.
+func NewGCEBackendBucket(projectID, backendBucketID string) (*MonitoredResource, error) {
+	return New(GCEBackendBucket, Label{
+		"project_id":        projectID,
+		"backend_bucket_id": backendBucketID,
+	})
+}
+
+// NewGCEBackendService returns the new Compute Engine Backend Service MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - backend_service_id: Unique identifier of the backend service.
+//   - location: Global or Compute Engine region containing the backend service
+func NewGCEBackendService(projectID, backendServiceID, location string) (*MonitoredResource, error) {
+	return New(GCEBackendService, Label{
+		"project_id":         projectID,
+		"backend_service_id": backendServiceID,
+		"location":           location,
+	})
+}
+
+// NewGCEClientSSLPolicy returns the new GCE Client SSL Policy MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - client_ssl_policy_id: Unique identifier of the client SSL policy.
+func NewGCEClientSSLPolicy(projectID, clientSSLPolicyID string) (*MonitoredResource, error) {
+	return New(GCEClientSSLPolicy, Label{
+		"project_id":           projectID,
+		"client_ssl_policy_id": clientSSLPolicyID,
+	})
+}
+
+// NewGCECommitment returns the new GCE Committed Use Discount MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - commitment_id: Unique identifier of the committed use discount.
+//   - location: GCE region where the committed use discount is active.
+func NewGCECommitment(projectID, commitmentID, location string) (*MonitoredResource, error) {
+	return New(GCECommitment, Label{
+		"project_id":    projectID,
+		"commitment_id": commitmentID,
+		"location":      location,
+	})
+}
+
+// NewGCEDisk returns the new Disk MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - disk_id: Unique identifier of the disk.
+//   - zone: The Compute Engine zone where the disk resides.
+func NewGCEDisk(projectID, diskID, zone string) (*MonitoredResource, error) {
+	return New(GCEDisk, Label{
+		"project_id": projectID,
+		"disk_id":    diskID,
+		"zone":       zone,
+	})
+}
+
+// NewGCEFirewallRule returns the new GCE Firewall Rule MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - firewall_rule_id: Unique identifier of the firewall rule.
+func NewGCEFirewallRule(projectID, firewallRuleID string) (*MonitoredResource, error) {
+	return New(GCEFirewallRule, Label{
+		"project_id":       projectID,
+		"firewall_rule_id": firewallRuleID,
+	})
+}
+
+// NewGCEForwardingRule returns the new GCE Forwarding Rule MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - forwarding_rule_id: Unique identifier of the forewarding rule.
+//   - region: GCE region where the forwarding rule resides.
+func NewGCEForwardingRule(projectID, forwardingRuleID, region string) (*MonitoredResource, error) {
+	return New(GCEForwardingRule, Label{
+		"project_id":         projectID,
+		"forwarding_rule_id": forwardingRuleID,
+		"region":             region,
+	})
+}
+
+// NewGCEHealthCheck returns the new GCE Health Check MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - health_check_id: Unique identifier of the health check.
+func NewGCEHealthCheck(projectID, healthCheckID string) (*MonitoredResource, error) {
+	return New(GCEHealthCheck, Label{
+		"project_id":      projectID,
+		"health_check_id": healthCheckID,
+	})
+}
+
+// NewGCEImage returns the new GCE Image MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - image_id: Unique numerical identifier of the image.
+func NewGCEImage(projectID, imageID string) (*MonitoredResource, error) {
+	return New(GCEImage, Label{
+		"project_id": projectID,
+		"image_id":   imageID,
+	})
+}
+
+// NewGCEInstance returns the new VM Instance MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - instance_id: The numeric VM instance identifier assigned by Compute Engine.
+//   - zone: The Compute Engine zone in which the VM is running.
+func NewGCEInstance(projectID, instanceID, zone string) (*MonitoredResource, error) {
+	return New(GCEInstance, Label{
+		"project_id":  projectID,
+		"instance_id": instanceID,
+		"zone":        zone,
+	})
+}
+
+// NewGCEInstanceGroup returns the new GCE Instance Group MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - instance_group_id: The unique numerical identifier of the instance group.
+//   - instance_group_name: The unique user provided name of the instance group.
+//   - location: GCE zone containing the instance group.
+func NewGCEInstanceGroup(projectID, instanceGroupID, instanceGroupName, location string) (*MonitoredResource, error) {
+	return New(GCEInstanceGroup, Label{
+		"project_id":          projectID,
+		"instance_group_id":   instanceGroupID,
+		"instance_group_name": instanceGroupName,
+		"location":            location,
+	})
+}
+
+// NewGCEInstanceGroupManager returns the new GCE Instance Group Manager MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - instance_group_manager_id: The unique numerical identifier of the instance group manager.
+//   - instance_group_manager_name: The unique user provided name of the instance group manager.
+//   - location: GCE zone or region where the instance group manager is located.
+func NewGCEInstanceGroupManager(projectID, instanceGroupManagerID, instanceGroupManagerName, location string) (*MonitoredResource, error) {
+	return New(GCEInstanceGroupManager, Label{
+		"project_id":                  projectID,
+		"instance_group_manager_id":   instanceGroupManagerID,
+		"instance_group_manager_name": instanceGroupManagerName,
+		"location":                    location,
+	})
+}
+
+// NewGCEInstanceTemplate returns the new GCE Instance Template MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - instance_template_id: The unique numerical identifier of the instance template.
+//   - instance_template_name: The unique user provided name of the instance template.
+func NewGCEInstanceTemplate(projectID, instanceTemplateID, instanceTemplateName string) (*MonitoredResource, error) {
+	return New(GCEInstanceTemplate, Label{
+		"project_id":             projectID,
+		"instance_template_id":   instanceTemplateID,
+		"instance_template_name": instanceTemplateName,
+	})
+}
+
+// NewGCELicense returns the new GCE License MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - license_id: Unique identifier of the license.
+func NewGCELicense(projectID, licenseID string) (*MonitoredResource, error) {
+	return New(GCELicense, Label{
+		"project_id": projectID,
+		"license_id": licenseID,
+	})
+}
+
+// NewGCENetwork returns the new GCE Network MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - network_id: Unique identifier of the network.
+func NewGCENetwork(projectID, networkID string) (*MonitoredResource, error) {
+	return New(GCENetwork, Label{
+		"project_id": projectID,
+		"network_id": networkID,
+	})
+}
+
+// NewGCENetworkEndpointGroup returns the new Network Endpoint Group MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - zone: The name of the zone where the network endpoint group is located.
+//   - network_endpoint_group_id: The ID of the network endpoint group.
+func NewGCENetworkEndpointGroup(projectID, zone, networkEndpointGroupID string) (*MonitoredResource, error) {
+	return New(GCENetworkEndpointGroup, Label{
+		"project_id":                projectID,
+		"zone":                      zone,
+		"network_endpoint_group_id": networkEndpointGroupID,
+	})
+}
+
+// NewGCENetworkRegion returns the new Network Region MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - network_id: The ID of the Compute Engine network.
+//   - region: The name of the network region.
+func NewGCENetworkRegion(projectID, networkID, region string) (*MonitoredResource, error) {
+	return New(GCENetworkRegion, Label{
+		"project_id": projectID,
+		"network_id": networkID,
+		"region":     region,
+	})
+}
+
+// NewGCENodeGroup returns the new Node Group MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - node_group_id: Unique identifier of the node group.
+//   - zone: Zone of the node group.
+func NewGCENodeGroup(projectID, nodeGroupID, zone string) (*MonitoredResource, error) {
+	return New(GCENodeGroup, Label{
+		"project_id":    projectID,
+		"node_group_id": nodeGroupID,
+		"zone":          zone,
+	})
+}
+
+// NewGCENodeTemplate returns the new Node Template MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - node_template_id: Unique identifier of the node template.
+//   - region: Region of the node template.
+func NewGCENodeTemplate(projectID, nodeTemplateID, region string) (*MonitoredResource, error) {
+	return New(GCENodeTemplate, Label{
+		"project_id":       projectID,
+		"node_template_id": nodeTemplateID,
+		"region":           region,
+	})
+}
+
+// NewGCEOperation returns the new GCE Operation MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - operation_name: The unique user provided name of the operation.
+//   - location: Location of the resource.
+func NewGCEOperation(projectID, operationName, location string) (*MonitoredResource, error) {
+	return New(GCEOperation, Label{
+		"project_id":     projectID,
+		"operation_name": operationName,
+		"location":       location,
+	})
+}
+
+// NewGCEPacketMirroring returns the new GCE Packet Mirroring MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - packet_mirroring_id: Unique identifier of the packet mirroring.
+//   - region: Region of the packet mirroring.
+func NewGCEPacketMirroring(projectID, packetMirroringID, region string) (*MonitoredResource, error) {
+	return New(GCEPacketMirroring, Label{
+		"project_id":          projectID,
+		"packet_mirroring_id": packetMirroringID,
+		"region":              region,
+	})
+}
+
+// NewGCEProject returns the new GCE Project MonitoredResource.
+//
+// The labels are:
+//   - project_id: GCE specific numeric identifier of the GCE project resource.
+func NewGCEProject(projectID string) (*MonitoredResource, error) {
+	return New(GCEProject, Label{
+		"project_id": projectID,
+	})
+}
+
+// NewGCEReservedAddress returns the new GCE Reserved Address MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - reserved_address_id: Unique identifier of the reserved address.
+//   - location: Global or GCE region containing the reserved address
+func NewGCEReservedAddress(projectID, reservedAddressID, location string) (*MonitoredResource, error) {
+	return New(GCEReservedAddress, Label{
+		"project_id":          projectID,
+		"reserved_address_id": reservedAddressID,
+		"location":            location,
+	})
+}
+
+// NewGCEResourcePolicy returns the new Resource Policy MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - resource_policy_id: Unique identifier of the resource policy.
+//   - region: Region of the resource policy.
+func NewGCEResourcePolicy(projectID, resourcePolicyID, region string) (*MonitoredResource, error) {
+	return New(GCEResourcePolicy, Label{
+		"project_id":         projectID,
+		"resource_policy_id": resourcePolicyID,
+		"region":             region,
+	})
+}
+
+// NewGCERoute returns the new GCE Route MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - route_id: Unique identifier of the route.
+func NewGCERoute(projectID, routeID string) (*MonitoredResource, error) {
+	return New(GCERoute, Label{
+		"project_id": projectID,
+		"route_id":   routeID,
+	})
+}
+
+// NewGCERouter returns the new GCE Router MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - router_id: Unique identifier of the router.
+//   - region: Region of the router.
+func NewGCERouter(projectID, routerID, region string) (*MonitoredResource, error) {
+	return New(GCERouter, Label{
+		"project_id": projectID,
+		"router_id":  routerID,
+		"region":     region,
+	})
+}
+
+// NewGCESnapshot returns the new GCE Snapshot MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - snapshot_id: Unique identifier of the snapshot.
+func NewGCESnapshot(projectID, snapshotID string) (*MonitoredResource, error) {
+	return New(GCESnapshot, Label{
+		"project_id":  projectID,
+		"snapshot_id": snapshotID,
+	})
+}
+
+// NewGCESSLCertificate returns the new GCE SSL Certificate MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - ssl_certificate_id: The unique numerical identifier of the SSL certificate.
+//   - ssl_certificate_name: The unique user provided name of the SSL Certificate.
+func NewGCESSLCertificate(projectID, sslCertificateID, sslCertificateName string) (*MonitoredResource, error) {
+	return New(GCESSLCertificate, Label{
+		"project_id":           projectID,
+		"ssl_certificate_id":   sslCertificateID,
+		"ssl_certificate_name": sslCertificateName,
+	})
+}
+
+// NewGCESubnetwork returns the new Subnetwork MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - subnetwork_id: The unique numerical identifier of the subnetwork.
+//   - subnetwork_name: The unique user provided name of the subnetwork.
+//   - location: Location of the resource.
+func NewGCESubnetwork(projectID, subnetworkID, subnetworkName, location string) (*MonitoredResource, error) {
+	return New(GCESubnetwork, Label{
+		"project_id":      projectID,
+		"subnetwork_id":   subnetworkID,
+		"subnetwork_name": subnetworkName,
+		"location":        location,
+	})
+}
+
+// NewGCETargetHTTPInstance returns the new GCE Target HTTP Instance MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - target_http_instance_id: Unique identifier of the target http instance.
+//   - zone: GCE zone where the target http instance resides.
+func NewGCETargetHTTPInstance(projectID, targetHTTPInstanceID, zone string) (*MonitoredResource, error) {
+	return New(GCETargetHTTPInstance, Label{
+		"project_id":              projectID,
+		"target_http_instance_id": targetHTTPInstanceID,
+		"zone":                    zone,
+	})
+}
+
+// NewGCETargetHTTPProxy returns the new GCE Target HTTP Proxy MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - target_http_proxy_id: Unique identifier of the target http proxy.
+func NewGCETargetHTTPProxy(projectID, targetHTTPProxyID string) (*MonitoredResource, error) {
+	return New(GCETargetHTTPProxy, Label{
+		"project_id":           projectID,
+		"target_http_proxy_id": targetHTTPProxyID,
+	})
+}
+
+// NewGCETargetHTTPSProxy returns the new GCE Target HTTPS Proxy MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - target_https_proxy_id: Unique identifier of the target https proxy.
+func NewGCETargetHTTPSProxy(projectID, targetHTTPSProxyID string) (*MonitoredResource, error) {
+	return New(GCETargetHTTPSProxy, Label{
+		"project_id":            projectID,
+		"target_https_proxy_id": targetHTTPSProxyID,
+	})
+}
+
+// NewGCETargetPool returns the new GCE Target Pool MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - target_pool_id: Unique identifier of the target pool.
+//   - zone: GCE zone where the pool resides.
+func NewGCETargetPool(projectID, targetPoolID, zone string) (*MonitoredResource, error) {
+	return New(GCETargetPool, Label{
+		"project_id":     projectID,
+		"target_pool_id": targetPoolID,
+		"zone":           zone,
+	})
+}
+
+// NewGCETargetSSLProxy returns the new GCE Target SSL Proxy MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - target_ssl_proxy_id: Unique identifier of the target ssl proxy.
+func NewGCETargetSSLProxy(projectID, targetSSLProxyID string) (*MonitoredResource, error) {
+	return New(GCETargetSSLProxy, Label{
+		"project_id":          projectID,
+		"target_ssl_proxy_id": targetSSLProxyID,
+	})
+}
+
+// NewGCEURLMap returns the new GCE URL Map MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - url_map_id: Unique identifier of the url map.
+func NewGCEURLMap(projectID, urlMapID string) (*MonitoredResource, error) {
+	return New(GCEURLMap, Label{
+		"project_id": projectID,
+		"url_map_id": urlMapID,
+	})
+}
+
+// NewGCSBucket returns the new GCS Bucket MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - bucket_name: An immutable name of the bucket.
+//   - location: Location of the bucket.
+func NewGCSBucket(projectID, bucketName, location string) (*MonitoredResource, error) {
+	return New(GCSBucket, Label{
+		"project_id":  projectID,
+		"bucket_name": bucketName,
+		"location":    location,
+	})
+}
+
+// NewGenericNode returns the new Generic Node MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location (optional): The GCP or AWS region in which data about the resource is stored. For example, "us-east1-a" (GCP) or "aws:us-east-1a" (AWS).
+//   - namespace (optional): A namespace identifier, such as a cluster name.
+//   - node_id: A unique identifier for the node within the namespace, such as a hostname or IP address.
+func NewGenericNode(projectID, location, namespace, nodeID string) (*MonitoredResource, error) {
+	return New(GenericNode, Label{
+		"project_id": projectID,
+		"location":   location,
+		"namespace":  namespace,
+		"node_id":    nodeID,
+	})
+}
+
+// NewGenericTask returns the new Generic Task MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location (optional): The GCP or AWS region in which data about the resource is stored. For example, "us-east1-a" (GCP) or "aws:us-east-1a" (AWS).
+//   - namespace (optional): A namespace identifier, such as a cluster name.
+//   - job: An identifier for a grouping of related tasks, such as the name of a microservice or distributed batch job.
+//   - task_id: A unique identifier for the task within the namespace and job, such as a replica index identifying the task within the job.
+func NewGenericTask(projectID, location, namespace, job, taskID string) (*MonitoredResource, error) {
+	return New(GenericTask, Label{
+		"project_id": projectID,
+		"location":   location,
+		"namespace":  namespace,
+		"job":        job,
+		"task_id":    taskID,
+	})
+}
+
+// NewGenomicsDataset returns the new Genomics Dataset MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - dataset_id: Unique identifier of the dataset.
+func NewGenomicsDataset(projectID, datasetID string) (*MonitoredResource, error) {
+	return New(GenomicsDataset, Label{
+		"project_id": projectID,
+		"dataset_id": datasetID,
+	})
+}
+
+// NewGenomicsOperation returns the new Genomics Operation MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - operation_id: Unique identifier of the long running operation.
+func NewGenomicsOperation(projectID, operationID string) (*MonitoredResource, error) {
+	return New(GenomicsOperation, Label{
+		"project_id":   projectID,
+		"operation_id": operationID,
+	})
+}
+
+// NewGKECluster returns the new GKE Cluster Operations MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - cluster_name: The name of the GKE Cluster.
+//   - location: The location in which the GKE Cluster is running.
+func NewGKECluster(projectID, clusterName, location string) (*MonitoredResource, error) {
+	return New(GKECluster, Label{
+		"project_id":   projectID,
+		"cluster_name": clusterName,
+		"location":     location,
+	})
+}
+
+// NewGKENodePool returns the new GKE Node Pool Operations MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - nodepool_name: The name of the GKE Node Pool.
+//   - location: The location in which the GKE Cluster is running.
+//   - cluster_name: The name of the GKE Cluster to which this Node Pool belongs.
+func NewGKENodePool(projectID, nodepoolName, location, clusterName string) (*MonitoredResource, error) {
+	return New(GKENodePool, Label{
+		"project_id":    projectID,
+		"nodepool_name": nodepoolName,
+		"location":      location,
+		"cluster_name":  clusterName,
+	})
+}
+
+// NewGKEBackupBackupPlan returns the new GKE Backup Plan MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the Google Cloud container associated with the resource.
+//   - location: The Google Cloud location where this backupPlan resides.
+//   - backup_plan_id: The name of the backupPlan.
+func NewGKEBackupBackupPlan(resourceContainer, location, backupPlanID string) (*MonitoredResource, error) {
+	return New(GKEBackupBackupPlan, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"backup_plan_id":     backupPlanID,
+	})
+}
+
+// NewGKEBackupRestorePlan returns the new GKE Restore Plan MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the Google Cloud container associated with the resource.
+//   - location: The Google Cloud location where this restorePlan resides.
+//   - restore_plan_id: The name of the restorePlan.
+func NewGKEBackupRestorePlan(resourceContainer, location, restorePlanID string) (*MonitoredResource, error) {
+	return New(GKEBackupRestorePlan, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"restore_plan_id":    restorePlanID,
+	})
+}
+
+// NewGlobal returns the new Global MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+func NewGlobal(projectID string) (*MonitoredResource, error) {
+	return New(Global, Label{
+		"project_id": projectID,
+	})
+}
+
+// NewHealthcareAnnotationStore returns the new Healthcare Annotation Store MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Google Cloud location of the dataset that contains the Annotation store.
+//   - dataset_id: The ID of the dataset.
+//   - annotation_store_id: The ID of the Annotation store.
+func NewHealthcareAnnotationStore(projectID, location, datasetID, annotationStoreID string) (*MonitoredResource, error) {
+	return New(HealthcareAnnotationStore, Label{
+		"project_id":          projectID,
+		"location":            location,
+		"dataset_id":          datasetID,
+		"annotation_store_id": annotationStoreID,
+	})
+}
+
+// NewHealthcareConsentStore returns the new Healthcare Consent Store MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Google Cloud location of the dataset that contains the Consent store.
+//   - dataset_id: The ID of the dataset.
+//   - consent_store_id: The ID of the Consent store.
+func NewHealthcareConsentStore(projectID, location, datasetID, consentStoreID string) (*MonitoredResource, error) {
+	return New(HealthcareConsentStore, Label{
+		"project_id":       projectID,
+		"location":         location,
+		"dataset_id":       datasetID,
+		"consent_store_id": consentStoreID,
+	})
+}
+
+// NewHealthcareDataset returns the new Healthcare Dataset MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Google Cloud location of the dataset.
+//   - dataset_id: The ID of the dataset.
+func NewHealthcareDataset(projectID, location, datasetID string) (*MonitoredResource, error) {
+	return New(HealthcareDataset, Label{
+		"project_id": projectID,
+		"location":   location,
+		"dataset_id": datasetID,
+	})
+}
+
+// NewHealthcareDICOMStore returns the new Healthcare DICOM Store MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Google Cloud location of the dataset that contains the DICOM store.
+//   - dataset_id: The ID of the dataset.
+//   - dicom_store_id: The ID of the DICOM store.
+func NewHealthcareDICOMStore(projectID, location, datasetID, dicomStoreID string) (*MonitoredResource, error) {
+	return New(HealthcareDICOMStore, Label{
+		"project_id":     projectID,
+		"location":       location,
+		"dataset_id":     datasetID,
+		"dicom_store_id": dicomStoreID,
+	})
+}
+
+// NewHealthcareFHIRStore returns the new Healthcare FHIR Store MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Google Cloud location of the dataset that contains the FHIR store.
+//   - dataset_id: The ID of the dataset.
+//   - fhir_store_id: The ID of the FHIR store.
+func NewHealthcareFHIRStore(projectID, location, datasetID, fhirStoreID string) (*MonitoredResource, error) {
+	return New(HealthcareFHIRStore, Label{
+		"project_id":    projectID,
+		"location":      location,
+		"dataset_id":    datasetID,
+		"fhir_store_id": fhirStoreID,
+	})
+}
+
+// NewHealthcareHL7v2Store returns the new Healthcare HL7v2 Store MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The Google Cloud location of the dataset that contains the HL7v2 store.
+//   - dataset_id: The ID of the dataset.
+//   - hl7v2_store_id: The ID of the HL7v2 store.
+func NewHealthcareHL7v2Store(projectID, location, datasetID, hl7v2StoreID string) (*MonitoredResource, error) {
+	return New(HealthcareHL7v2Store, Label{
+		"project_id":     projectID,
+		"location":       location,
+		"dataset_id":     datasetID,
+		"hl7v2_store_id": hl7v2StoreID,
+	})
+}
+
+// NewHTTPExternalRegionalLBRule returns the new HTTP/S External Regional Load Balancing Rule MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the Google Cloud project associated with this resource, such as 'my-project'.
+//   - network_name: The name of the customer network in which the Load Balancer resides.
+//   - region: The region under which the Load Balancer is defined.
+//   - url_map_name: The name of the urlmap.
+//   - forwarding_rule_name: The name of the forwarding rule.
+//   - target_proxy_name: The name of the target HTTP/S proxy.
+//   - matched_url_path_rule: The prefix of URL defined in urlmap tree. 'UNMATCHED' for the sink default rule.
+//   - backend_target_name: The name of the backend target or service.
+//   - backend_target_type: The type of the backend target. Can be 'BACKEND_SERVICE', or 'UNKNOWN' if the backend wasn't assigned.
+//   - backend_name: The name of the backend group. Can be ” if the backend wasn't assigned.
+//   - backend_type: The type of the backend group. Can be 'INSTANCE_GROUP', 'NETWORK_ENDPOINT_GROUP', or 'UNKNOWN' if the backend wasn't assigned.
+//   - backend_scope: The scope of the backend group. Can be 'UNKNOWN' if the backend wasn't assigned.
+//   - backend_scope_type: The type of the scope of the backend group. Can be 'ZONE', 'REGION', or 'UNKNOWN' in case the backend wasn't assigned.
+func NewHTTPExternalRegionalLBRule(projectID, networkName, region, urlMapName, forwardingRuleName, targetProxyName, matchedURLPathRule, backendTargetName, backendTargetType, backendName, backendType, backendScope, backendScopeType string) (*MonitoredResource, error) {
+	return New(HTTPExternalRegionalLBRule, Label{
+		"project_id":            projectID,
+		"network_name":          networkName,
+		"region":                region,
+		"url_map_name":          urlMapName,
+		"forwarding_rule_name":  forwardingRuleName,
+		"target_proxy_name":     targetProxyName,
+		"matched_url_path_rule": matchedURLPathRule,
+		"backend_target_name":   backendTargetName,
+		"backend_target_type":   backendTargetType,
+		"backend_name":          backendName,
+		"backend_type":          backendType,
+		"backend_scope":         backendScope,
+		"backend_scope_type":    backendScopeType,
+	})
+}
+
+// NewHTTPLoadBalancer returns the new Cloud HTTP Load Balancer MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - forwarding_rule_name: The name of the forwarding rule.
+//   - url_map_name: The name of the urlmap.
+//   - target_proxy_name: The name of the target proxy.
+//   - backend_service_name: The name of the backend service.
+//   - zone: The zone in which the load balancer is running.
+func NewHTTPLoadBalancer(projectID, forwardingRuleName, urlMapName, targetProxyName, backendServiceName, zone string) (*MonitoredResource, error) {
+	return New(HTTPLoadBalancer, Label{
+		"project_id":           projectID,
+		"forwarding_rule_name": forwardingRuleName,
+		"url_map_name":         urlMapName,
+		"target_proxy_name":    targetProxyName,
+		"backend_service_name": backendServiceName,
+		"zone":                 zone,
+	})
+}
+
+// NewIAMRole returns the new IAM Role MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - role_name: The name of the IAM custom role; this labelappears only on custom roles.(e.g., roles/[CUSTOM_ROLE],organizations/123456/roles/[CUSTOM_ROLE],projects/myproject/roles/[CUSTOM_ROLE]).
+func NewIAMRole(projectID, roleName string) (*MonitoredResource, error) {
+	return New(IAMRole, Label{
+		"project_id": projectID,
+		"role_name":  roleName,
+	})
+}
+
+// NewIdentityToolkitProject returns the new Project MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource.
+func NewIdentityToolkitProject(projectID string) (*MonitoredResource, error) {
+	return New(IdentityToolkitProject, Label{
+		"project_id": projectID,
+	})
+}
+
+// NewIdentityToolkitTenant returns the new Identity Toolkit Tenant MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource.
+//   - tenant_name: The name of the tenant.
+func NewIdentityToolkitTenant(projectID, tenantName string) (*MonitoredResource, error) {
+	return New(IdentityToolkitTenant, Label{
+		"project_id":  projectID,
+		"tenant_name": tenantName,
+	})
+}
+
+// NewIDSEndpoint returns the new IDS Endpoint MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP project owning the Endpoint.
+//   - location: The zone of the IDS Endpoint.
+//   - id: The ID of the Endpoint.
+func NewIDSEndpoint(resourceContainer, location, id string) (*MonitoredResource, error) {
+	return New(IDSEndpoint, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"id":                 id,
+	})
+}
+
+// NewIstioControlPlane returns the new Istio Control Plane MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - mesh_uid: Unique identifier for an Istio service mesh.
+//   - location: The physical location in which the workload for the Control Plane is located.
+//   - revision: Immutable revision of Istio managed by the Control Plane.
+//   - build_id: Immutable build tag for the instance of the Control Plane.
+//   - owner: Immutable name of the owner of the Control Plane.
+func NewIstioControlPlane(projectID, meshUID, location, revision, buildID, owner string) (*MonitoredResource, error) {
+	return New(IstioControlPlane, Label{
+		"project_id": projectID,
+		"mesh_uid":   meshUID,
+		"location":   location,
+		"revision":   revision,
+		"build_id":   buildID,
+		"owner":      owner,
+	})
+}
+
+// NewK8sCluster returns the new Kubernetes Cluster MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The physical location of the cluster.
+//   - cluster_name: The name of the cluster.
+func NewK8sCluster(projectID, location, clusterName string) (*MonitoredResource, error) {
+	return New(K8sCluster, Label{
+		"project_id":   projectID,
+		"location":     location,
+		"cluster_name": clusterName,
+	})
+}
+
+// NewK8sContainer returns the new Kubernetes Container MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The physical location of the cluster that contains the container.
+//   - cluster_name: The name of the cluster that the container is running in.
+//   - namespace_name: The name of the namespace that the container is running in.
+//   - pod_name: The name of the pod that the container is running in.
+//   - container_name: The name of the container.
+func NewK8sContainer(projectID, location, clusterName, namespaceName, podName, containerName string) (*MonitoredResource, error) {
+	return New(K8sContainer, Label{
+		"project_id":     projectID,
+		"location":       location,
+		"cluster_name":   clusterName,
+		"namespace_name": namespaceName,
+		"pod_name":       podName,
+		"container_name": containerName,
+	})
+}
+
+// NewK8sControlPlaneComponent returns the new Kubernetes Control Plane Component MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The physical location of the cluster that contains the control plane component.
+//   - cluster_name: The name of the cluster that the control plane component is running in.
+//   - component_name: The name of the control plane component.
+//   - component_location: The physical location where the control plane component is running.
+func NewK8sControlPlaneComponent(projectID, location, clusterName, componentName, componentLocation string) (*MonitoredResource, error) {
+	return New(K8sControlPlaneComponent, Label{
+		"project_id":         projectID,
+		"location":           location,
+		"cluster_name":       clusterName,
+		"component_name":     componentName,
+		"component_location": componentLocation,
+	})
+}
+
+// NewK8sNode returns the new Kubernetes Node MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The physical location of the cluster that contains the node.
+//   - cluster_name: The name of the cluster that the node is a part of.
+//   - node_name: The name of the node.
+func NewK8sNode(projectID, location, clusterName, nodeName string) (*MonitoredResource, error) {
+	return New(K8sNode, Label{
+		"project_id":   projectID,
+		"location":     location,
+		"cluster_name": clusterName,
+		"node_name":    nodeName,
+	})
+}
+
+// NewK8sPod returns the new Kubernetes Pod MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The physical location of the cluster that contains the pod.
+//   - cluster_name: The name of the cluster that the pod is running in.
+//   - namespace_name: The name of the namespace that the pod is running in.
+//   - pod_name: The name of the pod.
+func NewK8sPod(projectID, location, clusterName, namespaceName, podName string) (*MonitoredResource, error) {
+	return New(K8sPod, Label{
+		"project_id":     projectID,
+		"location":       location,
+		"cluster_name":   clusterName,
+		"namespace_name": namespaceName,
+		"pod_name":       podName,
+	})
+}
+
+// NewK8sService returns the new Kubernetes Service MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The physical location of the cluster that contains the service.
+//   - cluster_name: The name of the cluster that the service is running in.
+//   - namespace_name: The name of the namespace that the service is running in.
+//   - service_name: The name of the service.
+func NewK8sService(projectID, location, clusterName, namespaceName, serviceName string) (*MonitoredResource, error) {
+	return New(K8sService, Label{
+		"project_id":     projectID,
+		"location":       location,
+		"cluster_name":   clusterName,
+		"namespace_name": namespaceName,
+		"service_name":   serviceName,
+	})
+}
+
+// NewL4ProxyRule returns the new Layer 4 Proxying Rule for TCP/UDP/SSL Traffic MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the Google Cloud project associated with this resource, such as 'my-project'.
+//   - network_name: The name of the customer network in which the Load Balancer resides.
+//   - region: The region under which the Load Balancer is defined.
+//   - load_balancing_scheme: The load balancing scheme associated with the forwarding rule, one of [INTERNAL_MANAGED, EXTERNAL_MANAGED].
+//   - protocol: The protocol associated with the traffic processed by the proxy, one of [TCP, UDP, SSL, UNKNOWN].
+//   - forwarding_rule_name: The name of the forwarding rule.
+//   - target_proxy_name: The name of the target proxy.
+//   - backend_target_name: The name of the backend target or service.
+//   - backend_target_type: The type of the backend target, one of ['BACKEND_SERVICE'; 'UNKNOWN' - if the backend wasn't assigned].
+//   - backend_name: The name of the backend group. Can be ” if the backend wasn't assigned.
+//   - backend_type: The type of the backend group, one of ['INSTANCE_GROUP'; 'NETWORK_ENDPOINT_GROUP'; 'UNKNOWN' - if the backend wasn't assigned].
+//   - backend_scope: The scope of the backend group. Can be 'UNKNOWN' if the backend wasn't assigned.
+//   - backend_scope_type: The type of the scope of the backend group, one of ['ZONE'; 'REGION'; 'UNKNOWN' - in case the backend wasn't assigned].
+func NewL4ProxyRule(projectID, networkName, region, loadBalancingScheme, protocol, forwardingRuleName, targetProxyName, backendTargetName, backendTargetType, backendName, backendType, backendScope, backendScopeType string) (*MonitoredResource, error) {
+	return New(L4ProxyRule, Label{
+		"project_id":            projectID,
+		"network_name":          networkName,
+		"region":                region,
+		"load_balancing_scheme": loadBalancingScheme,
+		"protocol":              protocol,
+		"forwarding_rule_name":  forwardingRuleName,
+		"target_proxy_name":     targetProxyName,
+		"backend_target_name":   backendTargetName,
+		"backend_target_type":   backendTargetType,
+		"backend_name":          backendName,
+		"backend_type":          backendType,
+		"backend_scope":         backendScope,
+		"backend_scope_type":    backendScopeType,
+	})
+}
+
+// NewLiveStreamChannel returns the new Live Stream API Channel MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP project associated with this channel resource.
+//   - location: The GCP location where the channel resource resides.
+//   - channel_id: ID of the channel resource.
+func NewLiveStreamChannel(resourceContainer, location, channelID string) (*MonitoredResource, error) {
+	return New(LiveStreamChannel, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"channel_id":         channelID,
+	})
+}
+
+// NewLoadBalancingExternalNetworkLoadBalancerRule returns the new Google Cloud External Network Load Balancer Rule MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - region: The Google Cloud Platform region of the backend instance that connected to network load balancing forwarding rule.
+//   - backend_network_name: The network name of the NIC of the instance that received the Net LB flow.
+//   - backend_target_type: The type of the backend target that handled the connection.
+//   - backend_service_name: The name of the backend service that handled the connection.
+//   - primary_target_pool: The name of the primary target pool.
+//   - target_pool: The name of the target pool.
+//   - forwarding_rule_name: The name of the forwarding rule.
+//   - backend_group_name: The name of the backend group that handled the connection.
+//   - backend_group_type: The type of the backend group that handled the connection.
+//   - backend_group_scope: The scope (zone or region) of the backend group that handled the connection.
+//   - backend_subnetwork_name: The name of the subnetwork of the instance that handled the connection.
+//   - backend_zone: The zone of the endpoint (VM instance) that handled the connection.
+func NewLoadBalancingExternalNetworkLoadBalancerRule(projectID, region, backendNetworkName, backendTargetType, backendServiceName, primaryTargetPool, targetPool, forwardingRuleName, backendGroupName, backendGroupType, backendGroupScope, backendSubnetworkName, backendZone string) (*MonitoredResource, error) {
+	return New(LoadBalancingExternalNetworkLoadBalancerRule, Label{
+		"project_id":              projectID,
+		"region":                  region,
+		"backend_network_name":    backendNetworkName,
+		"backend_target_type":     backendTargetType,
+		"backend_service_name":    backendServiceName,
+		"primary_target_pool":     primaryTargetPool,
+		"target_pool":             targetPool,
+		"forwarding_rule_name":    forwardingRuleName,
+		"backend_group_name":      backendGroupName,
+		"backend_group_type":      backendGroupType,
+		"backend_group_scope":     backendGroupScope,
+		"backend_subnetwork_name": backendSubnetworkName,
+		"backend_zone":            backendZone,
+	})
+}
+
+// NewLoadBalancingInternalNetworkLoadBalancerRule returns the new Google Cloud Internal Network Load Balancer Rule MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - region: The Google Cloud Platform region of the backend instance that connected to network load balancing forwarding rule.
+//   - backend_network_name: The network name of the NIC of the instance that received the Net LB flow.
+//   - backend_service_name: The name of the backend service that handled the connection.
+//   - forwarding_rule_name: The name of the forwarding rule.
+//   - backend_group_name: The name of the backend group that handled the connection.
+//   - backend_group_type: The type of the backend group that handled the connection.
+//   - backend_group_scope: The scope (zone or region) of the backend group that handled the connection.
+//   - backend_subnetwork_name: The name of the subnetwork of the instance that handled the connection.
+func NewLoadBalancingInternalNetworkLoadBalancerRule(projectID, region, backendNetworkName, backendServiceName, forwardingRuleName, backendGroupName, backendGroupType, backendGroupScope, backendSubnetworkName string) (*MonitoredResource, error) {
+	return New(LoadBalancingInternalNetworkLoadBalancerRule, Label{
+		"project_id":              projectID,
+		"region":                  region,
+		"backend_network_name":    backendNetworkName,
+		"backend_service_name":    backendServiceName,
+		"forwarding_rule_name":    forwardingRuleName,
+		"backend_group_name":      backendGroupName,
+		"backend_group_type":      backendGroupType,
+		"backend_group_scope":     backendGroupScope,
+		"backend_subnetwork_name": backendSubnetworkName,
+	})
+}
+
+// NewLoggingBucket returns the new Logging Bucket MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - bucket_id: The name of the log bucket.
+//   - location: The location of the log bucket.
+//   - source_resource_container: The source resource container (e.g. project, folder, organization) of the log entry that is destined for the log bucket. The format is "projects/project_id"
+//   - monitored_resource_type: The type field of the monitored resource in the log entry that is destined for the log bucket.
+func NewLoggingBucket(projectID, bucketID, location, sourceResourceContainer, monitoredResourceType string) (*MonitoredResource, error) {
+	return New(LoggingBucket, Label{
+		"project_id":                projectID,
+		"bucket_id":                 bucketID,
+		"location":                  location,
+		"source_resource_container": sourceResourceContainer,
+		"monitored_resource_type":   monitoredResourceType,
+	})
+}
+
+// NewLoggingExclusion returns the new Log Exclusion MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - name: The unique name of the exclusion.
+func NewLoggingExclusion(projectID, name string) (*MonitoredResource, error) {
+	return New(LoggingExclusion, Label{
+		"project_id": projectID,
+		"name":       name,
+	})
+}
+
+// NewLoggingLog returns the new Log stream MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - name: Unique identifier of the log.
+func NewLoggingLog(projectID, name string) (*MonitoredResource, error) {
+	return New(LoggingLog, Label{
+		"project_id": projectID,
+		"name":       name,
+	})
+}
+
+// NewLoggingSink returns the new Logging export sink MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - name: The unique name of the sink.
+//   - destination: The destination of the sink.
+func NewLoggingSink(projectID, name, destination string) (*MonitoredResource, error) {
+	return New(LoggingSink, Label{
+		"project_id":  projectID,
+		"name":        name,
+		"destination": destination,
+	})
+}
+
+// NewManagedService returns the new Managed Service MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - service_name: The name of the service.
+//   - producer_project_id: The id of the project which produces and owns this service.
+func NewManagedService(projectID, serviceName, producerProjectID string) (*MonitoredResource, error) {
+	return New(ManagedService, Label{
+		"project_id":          projectID,
+		"service_name":        serviceName,
+		"producer_project_id": producerProjectID,
+	})
+}
+
+// NewMesh returns the new Mesh MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The location of the control plane
+//   - mesh: The name of the mesh
+func NewMesh(projectID, location, mesh string) (*MonitoredResource, error) {
+	return New(Mesh, Label{
+		"project_id": projectID,
+		"location":   location,
+		"mesh":       mesh,
+	})
+}
+
+// NewMetastoreService returns the new Dataproc Metastore Service MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The ID of the customer project.
+//   - location: The region that the service is hosted in.
+//   - service_id: The service ID.
+func NewMetastoreService(resourceContainer, location, serviceID string) (*MonitoredResource, error) {
+	return New(MetastoreService, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"service_id":         serviceID,
+	})
+}
+
+// NewMetric returns the new Metric Type MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - name: The name of the metric type, such as "logging.googleapis.com/my-metric-name".
+func NewMetric(projectID, name string) (*MonitoredResource, error) {
+	return New(Metric, Label{
+		"project_id": projectID,
+		"name":       name,
+	})
+}
+
+// NewMLJob returns the new Cloud ML Job MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - job_id: The job identifier.
+//   - task_name: The task name.
+func NewMLJob(projectID, jobID, taskName string) (*MonitoredResource, error) {
+	return New(MLJob, Label{
+		"project_id": projectID,
+		"job_id":     jobID,
+		"task_name":  taskName,
+	})
+}
+
+// NewNATGateway returns the new Cloud NAT Gateway MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - region: The region where the NAT gateway is located.
+//   - router_id: Identifier of the router under which the NAT gateway is defined.
+//   - gateway_name: The name of the NAT gateway.
+func NewNATGateway(projectID, region, routerID, gatewayName string) (*MonitoredResource, error) {
+	return New(NATGateway, Label{
+		"project_id":   projectID,
+		"region":       region,
+		"router_id":    routerID,
+		"gateway_name": gatewayName,
+	})
+}
+
+// NewNetworkSecurityPolicy returns the new Network Security Policy MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - policy_name: The unique user provided name of the security policy.
+func NewNetworkSecurityPolicy(projectID, policyName string) (*MonitoredResource, error) {
+	return New(NetworkSecurityPolicy, Label{
+		"project_id":  projectID,
+		"policy_name": policyName,
+	})
+}
+
+// NewNetworkingLocation returns the new GCP Location MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: Name of a GCP zone/region, or "global".
+func NewNetworkingLocation(projectID, location string) (*MonitoredResource, error) {
+	return New(NetworkingLocation, Label{
+		"project_id": projectID,
+		"location":   location,
+	})
+}
+
+// NewOrganization returns the new Google Organization MonitoredResource.
+//
+// The labels are:
+//   - organization_id: Numeric id of the organization.
+func NewOrganization(organizationID string) (*MonitoredResource, error) {
+	return New(Organization, Label{
+		"organization_id": organizationID,
+	})
+}
+
+// NewProject returns the new Google Project MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource (e.g., my-project).
+func NewProject(projectID string) (*MonitoredResource, error) {
+	return New(Project, Label{
+		"project_id": projectID,
+	})
+}
+
+// NewPubSubSnapshot returns the new Cloud Pub/Sub Snapshot MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - snapshot_id: The identifier of the snapshot, such as "my-snapshot".
+func NewPubSubSnapshot(projectID, snapshotID string) (*MonitoredResource, error) {
+	return New(PubSubSnapshot, Label{
+		"project_id":  projectID,
+		"snapshot_id": snapshotID,
+	})
+}
+
+// NewPubSubSubscription returns the new Cloud Pub/Sub Subscription MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - subscription_id: The identifier of the subscription, such as "my-subscription".
+func NewPubSubSubscription(projectID, subscriptionID string) (*MonitoredResource, error) {
+	return New(PubSubSubscription, Label{
+		"project_id":      projectID,
+		"subscription_id": subscriptionID,
+	})
+}
+
+// NewPubSubTopic returns the new Cloud Pub/Sub Topic MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - topic_id: The identifier of the topic, such as "my-topic".
+func NewPubSubTopic(projectID, topicID string) (*MonitoredResource, error) {
+	return New(PubSubTopic, Label{
+		"project_id": projectID,
+		"topic_id":   topicID,
+	})
+}
+
+// NewRecaptchaEnterpriseKey returns the new reCAPTCHA Key MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The ID of the GCP project associated with this reCAPTCHA Key.
+//   - location: Location where the reCAPTCHA Key is provisioned.
+//   - key_id: The ID for this Key.
+func NewRecaptchaEnterpriseKey(resourceContainer, location, keyID string) (*MonitoredResource, error) {
+	return New(RecaptchaEnterpriseKey, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"key_id":             keyID,
+	})
+}
+
+// NewRecommender returns the new Recommender MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - recommender_id: The name of the recommender.
+//   - location: The location of the recommendation.
+func NewRecommender(projectID, recommenderID, location string) (*MonitoredResource, error) {
+	return New(Recommender, Label{
+		"project_id":     projectID,
+		"recommender_id": recommenderID,
+		"location":       location,
+	})
+}
+
+// NewRecommenderInsightType returns the new InsightType MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - insight_type_id: The resource ID of the insight type.
+//   - location: The location of the insight.
+func NewRecommenderInsightType(projectID, insightTypeID, location string) (*MonitoredResource, error) {
+	return New(RecommenderInsightType, Label{
+		"project_id":      projectID,
+		"insight_type_id": insightTypeID,
+		"location":        location,
+	})
+}
+
+// NewRedisInstance returns the new Cloud Memorystore Redis Instance MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - region: The Google Cloud region in which the managed instance is running.
+//   - instance_id: The ID of the managed instance.
+//   - node_id: The ID of a Redis node within the managed instance.
+func NewRedisInstance(projectID, region, instanceID, nodeID string) (*MonitoredResource, error) {
+	return New(RedisInstance, Label{
+		"project_id":  projectID,
+		"region":      region,
+		"instance_id": instanceID,
+		"node_id":     nodeID,
+	})
+}
+
+// NewReportedErrors returns the new Reported Errors MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+func NewReportedErrors(projectID string) (*MonitoredResource, error) {
+	return New(ReportedErrors, Label{
+		"project_id": projectID,
+	})
+}
+
+// NewSecretManagerSecret returns the new Secret Manager Secret MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP project associated with this resource.
+//   - location: Location of secret metadata. Always global.
+//   - secret_id: The name given to this secret.
+func NewSecretManagerSecret(resourceContainer, location, secretID string) (*MonitoredResource, error) {
+	return New(SecretManagerSecret, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"secret_id":          secretID,
+	})
+}
+
+// NewServiceAccount returns the new Service Account MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource (e.g., my-project).
+//   - email_id: The service account email id, e.g. "account123@proj123.iam.gserviceaccount.com".
+//   - unique_id: The unique id of the service account, e.g. "113948692397867021414".
+func NewServiceAccount(projectID, emailID, uniqueID string) (*MonitoredResource, error) {
+	return New(ServiceAccount, Label{
+		"project_id": projectID,
+		"email_id":   emailID,
+		"unique_id":  uniqueID,
+	})
+}
+
+// NewServiceConfig returns the new Service Configuration MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - service_name: The name of the service.
+//   - service_config_id: The id of the service configuration.
+func NewServiceConfig(projectID, serviceName, serviceConfigID string) (*MonitoredResource, error) {
+	return New(ServiceConfig, Label{
+		"project_id":        projectID,
+		"service_name":      serviceName,
+		"service_config_id": serviceConfigID,
+	})
+}
+
+// NewServiceRollout returns the new Service Rollout MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - service_name: The name of the service.
+//   - rollout_id: The id of the service rollout.
+func NewServiceRollout(projectID, serviceName, rolloutID string) (*MonitoredResource, error) {
+	return New(ServiceRollout, Label{
+		"project_id":   projectID,
+		"service_name": serviceName,
+		"rollout_id":   rolloutID,
+	})
+}
+
+// NewServiceDirectoryNamespace returns the new Service Directory Namespace MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The cloud region of the Service Directory namespace.
+//   - namespace_name: The name of the Service Directory namespace.
+func NewServiceDirectoryNamespace(projectID, location, namespaceName string) (*MonitoredResource, error) {
+	return New(ServiceDirectoryNamespace, Label{
+		"project_id":     projectID,
+		"location":       location,
+		"namespace_name": namespaceName,
+	})
+}
+
+// NewServiceUsageService returns the new Service MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - service_name: The name of the service.
+func NewServiceUsageService(projectID, serviceName string) (*MonitoredResource, error) {
+	return New(ServiceUsageService, Label{
+		"project_id":   projectID,
+		"service_name": serviceName,
+	})
+}
+
+// NewServiceUserService returns the new Service MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - service_name: The name of the service.
+func NewServiceUserService(projectID, serviceName string) (*MonitoredResource, error) {
+	return New(ServiceUserService, Label{
+		"project_id":   projectID,
+		"service_name": serviceName,
+	})
+}
+
+// NewSpannerInstance returns the new Cloud Spanner Instance MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - instance_id: An immutable identifier for an instance.
+//   - location: Cloud Spanner region.
+//   - instance_config: Instance config for the instance.
+func NewSpannerInstance(projectID, instanceID, location, instanceConfig string) (*MonitoredResource, error) {
+	return New(SpannerInstance, Label{
+		"project_id":      projectID,
+		"instance_id":     instanceID,
+		"location":        location,
+		"instance_config": instanceConfig,
+	})
+}
+
+// NewStorageTransferJob returns the new Cloud Storage Transfer Job MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - job_id: A unique name of the storage transfer job.
+func NewStorageTransferJob(projectID, jobID string) (*MonitoredResource, error) {
+	return New(StorageTransferJob, Label{
+		"project_id": projectID,
+		"job_id":     jobID,
+	})
+}
+
+// NewTCPSSLProxyRule returns the new Google Cloud TCP/SSL Proxy Rule MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - region: The region on which TCP/SSL proxy is applied, such as 'global' or 'us-central1'. Various other objects are defined per that locality.
+//   - backend_target_name: The name of the backend target ('backend service', equivalent to 'proxy name').
+//   - backend_target_type: The type of the backend target. Can only be 'BACKEND_SERVICE' currently.
+//   - forwarding_rule_name: The name of the forwarding rule.
+//   - target_proxy_name: The name of the target TCP/SSL proxy.
+//   - backend_name: The name of the backend group.
+//   - backend_type: The type of the backend group. Can be 'INSTANCE_GROUP' or 'NETWORK_ENDPOINT_GROUP'.
+//   - backend_scope: The scope (zone or region) of the backend group.
+//   - backend_scope_type: The type of the scope of the backend group. Can be either 'ZONE' or 'REGION'.
+func NewTCPSSLProxyRule(projectID, region, backendTargetName, backendTargetType, forwardingRuleName, targetProxyName, backendName, backendType, backendScope, backendScopeType string) (*MonitoredResource, error) {
+	return New(TCPSSLProxyRule, Label{
+		"project_id":           projectID,
+		"region":               region,
+		"backend_target_name":  backendTargetName,
+		"backend_target_type":  backendTargetType,
+		"forwarding_rule_name": forwardingRuleName,
+		"target_proxy_name":    targetProxyName,
+		"backend_name":         backendName,
+		"backend_type":         backendType,
+		"backend_scope":        backendScope,
+		"backend_scope_type":   backendScopeType,
+	})
+}
+
+// NewTestServiceMatrix returns the new Test Matrix MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - matrix_id: Unique identifier of the matrix.
+func NewTestServiceMatrix(projectID, matrixID string) (*MonitoredResource, error) {
+	return New(TestServiceMatrix, Label{
+		"project_id": projectID,
+		"matrix_id":  matrixID,
+	})
+}
+
+// NewThreatDetector returns the new Threat Detector MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - detector_name: The specific detector that triggered the alert.
+func NewThreatDetector(projectID, detectorName string) (*MonitoredResource, error) {
+	return New(ThreatDetector, Label{
+		"project_id":    projectID,
+		"detector_name": detectorName,
+	})
+}
+
+// NewUptimeURL returns the new Uptime Check URL MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - host: The hostname or IP address of the check.
+func NewUptimeURL(projectID, host string) (*MonitoredResource, error) {
+	return New(UptimeURL, Label{
+		"project_id": projectID,
+		"host":       host,
+	})
+}
+
+// NewVMMigrationMigratingVM returns the new Migrate to Virtual Machines Migrating VM MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP project associated with this VM resource.
+//   - location: The GCP location where the VM resource resides.
+//   - source: The source where the VM resource resides.
+//   - vm: The VM ID.
+func NewVMMigrationMigratingVM(resourceContainer, location, source, vm string) (*MonitoredResource, error) {
+	return New(VMMigrationMigratingVM, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"source":             source,
+		"vm":                 vm,
+	})
+}
+
+// NewVMMigrationSource returns the new Migrate to Virtual Machines Source MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP project associated with this source resource.
+//   - location: The GCP location where the source resource resides.
+//   - source: The source ID.
+func NewVMMigrationSource(resourceContainer, location, source string) (*MonitoredResource, error) {
+	return New(VMMigrationSource, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"source":             source,
+	})
+}
+
+// NewVPCAccessConnector returns the new VPC Access Connector MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - location: The region the connector is located in.
+//   - connector_name: The name of the connector.
+func NewVPCAccessConnector(projectID, location, connectorName string) (*MonitoredResource, error) {
+	return New(VPCAccessConnector, Label{
+		"project_id":     projectID,
+		"location":       location,
+		"connector_name": connectorName,
+	})
+}
+
+// NewVPNGateway returns the new Cloud VPN Gateway MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - gateway_id: The VPN Gateway ID.
+//   - region: The region in which the VPN Gateway is running.
+func NewVPNGateway(projectID, gatewayID, region string) (*MonitoredResource, error) {
+	return New(VPNGateway, Label{
+		"project_id": projectID,
+		"gateway_id": gatewayID,
+		"region":     region,
+	})
+}
+
+// NewVPNTunnel returns the new Cloud VPN Tunnel MonitoredResource.
+//
+// The labels are:
+//   - project_id: The identifier of the GCP project associated with this resource, such as "my-project".
+//   - tunnel_id: The unique numerical identifier of the VPN tunnel.
+//   - tunnel_name: The unique user provided name of the VPN tunnel.
+//   - location: Location of the Cloud VPN Tunnel.
+func NewVPNTunnel(projectID, tunnelID, tunnelName, location string) (*MonitoredResource, error) {
+	return New(VPNTunnel, Label{
+		"project_id":  projectID,
+		"tunnel_id":   tunnelID,
+		"tunnel_name": tunnelName,
+		"location":    location,
+	})
+}
+
+// NewWorkflowsWorkflow returns the new Workflow MonitoredResource.
+//
+// The labels are:
+//   - resource_container: The identifier of the GCP container associated with the resource.
+//   - location: The region in which the workflow is deployed.
+//   - workflow_id: The ID of the workflow.
+func NewWorkflowsWorkflow(resourceContainer, location, workflowID string) (*MonitoredResource, error) {
+	return New(WorkflowsWorkflow, Label{
+		"resource_container": resourceContainer,
+		"location":           location,
+		"workflow_id":        workflowID,
+	})
+}
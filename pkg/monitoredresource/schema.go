@@ -0,0 +1,180 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package monitoredresource
+
+//go:generate go run ./internal/gen -o resources_gen.go resources.json
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	mrpb "google.golang.org/genproto/googleapis/api/monitoredres"
+)
+
+var (
+	// ErrUnknownType is returned by Validate if the monitored resource type is not in the catalogue.
+	ErrUnknownType = errors.New("monitoredresource: unknown monitored resource type")
+
+	// ErrMissingLabel is returned by Validate if the required label of the monitored resource type is not set.
+	ErrMissingLabel = errors.New("monitoredresource: missing label")
+
+	// ErrUnknownLabel is returned by Validate if the label is not defined by the monitored resource type.
+	ErrUnknownLabel = errors.New("monitoredresource: unknown label")
+
+	// ErrInvalidLabel is returned by Validate if the label value does not match the format of the label.
+	ErrInvalidLabel = errors.New("monitoredresource: invalid label")
+)
+
+// LabelFormat is the format of the label value.
+type LabelFormat string
+
+const (
+	// AnyFormat accepts any label value.
+	AnyFormat LabelFormat = ""
+
+	// GCPRegionFormat is the Google Cloud region, such as "us-central1".
+	GCPRegionFormat LabelFormat = "gcp_region"
+
+	// GCPZoneFormat is the Google Cloud zone, such as "us-central1-a".
+	GCPZoneFormat LabelFormat = "gcp_zone"
+
+	// GCPLocationFormat is the Google Cloud region or zone.
+	GCPLocationFormat LabelFormat = "gcp_location"
+
+	// AWSRegionFormat is the AWS region prefixed with "aws:", such as "aws:us-east-1".
+	AWSRegionFormat LabelFormat = "aws_region"
+
+	// LocationFormat is the Google Cloud region or zone, "global", or the AWS region or availability zone prefixed
+	// with "aws:", such as "aws:us-east-1a".
+	LocationFormat LabelFormat = "location"
+)
+
+const (
+	gcpRegionPattern = `[a-z]+-[a-z]+[0-9]+`
+	gcpZonePattern   = gcpRegionPattern + `-[a-z]`
+	awsRegionPattern = `aws:[a-z]{2}(-gov)?-[a-z]+-[0-9]+`
+)
+
+// labelFormats is the map of the label formats to their patterns.
+var labelFormats = map[LabelFormat]*regexp.Regexp{
+	GCPRegionFormat:   regexp.MustCompile(`^` + gcpRegionPattern + `$`),
+	GCPZoneFormat:     regexp.MustCompile(`^` + gcpZonePattern + `$`),
+	GCPLocationFormat: regexp.MustCompile(`^` + gcpRegionPattern + `(-[a-z])?$`),
+	AWSRegionFormat:   regexp.MustCompile(`^` + awsRegionPattern + `$`),
+	LocationFormat:    regexp.MustCompile(`^(global|` + gcpRegionPattern + `(-[a-z])?|` + awsRegionPattern + `[a-z]?)$`),
+}
+
+// Match reports whether val matches the format.
+func (f LabelFormat) Match(val string) bool {
+	re, ok := labelFormats[f]
+	if !ok {
+		return true
+	}
+
+	return re.MatchString(val)
+}
+
+// Descriptor describes the monitored resource type and its labels.
+//
+// The descriptors are generated from resources.json, which is the machine-readable catalogue of
+//
+//	https://cloud.google.com/logging/docs/api/v2/resource-list
+type Descriptor struct {
+	Type        Type
+	DisplayName string
+	Description string
+	Labels      []LabelDescriptor
+}
+
+// LabelDescriptor describes the label of the monitored resource type.
+//
+// The label is required unless Optional is true, and the non-empty value must match Format.
+type LabelDescriptor struct {
+	Key         string
+	Description string
+	Optional    bool
+	Format      LabelFormat
+}
+
+// Descriptor returns the descriptor of t, or nil if t is not in the catalogue.
+func (t Type) Descriptor() *Descriptor {
+	return descriptors[t]
+}
+
+// Types returns the all monitored resource types in the catalogue in sorted order.
+func Types() []Type {
+	types := make([]Type, 0, len(descriptors))
+	for t := range descriptors {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	return types
+}
+
+// New returns the new MonitoredResource of t with labels.
+//
+// New returns the error of Validate if labels do not match the labels of t. The LogID of the returned
+// MonitoredResource is "stdout".
+func New(t Type, labels Label) (*MonitoredResource, error) {
+	mr := &MonitoredResource{
+		LogID: "stdout",
+		MonitoredResource: &mrpb.MonitoredResource{
+			Type:   string(t),
+			Labels: labels,
+		},
+	}
+	if err := mr.Validate(); err != nil {
+		return nil, err
+	}
+
+	return mr, nil
+}
+
+// Validate reports whether the labels of mr match the descriptor of its type.
+//
+// The returned error joins the all problems: ErrUnknownType if the type is not in the catalogue, ErrMissingLabel
+// for the required labels which are not set, ErrUnknownLabel for the labels which are not defined by the type, and
+// ErrInvalidLabel for the label values which do not match the format.
+func (mr *MonitoredResource) Validate() error {
+	if mr == nil || mr.MonitoredResource == nil {
+		return fmt.Errorf("%w: nil MonitoredResource", ErrUnknownType)
+	}
+
+	t := Type(mr.GetType())
+	desc := t.Descriptor()
+	if desc == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownType, t)
+	}
+
+	var errs []error
+	keys := make(map[string]bool, len(desc.Labels))
+	for _, l := range desc.Labels {
+		keys[l.Key] = true
+
+		val := mr.Labels[l.Key]
+		switch {
+		case val == "":
+			if !l.Optional {
+				errs = append(errs, fmt.Errorf("%w %q of %s", ErrMissingLabel, l.Key, t))
+			}
+		case !l.Format.Match(val):
+			errs = append(errs, fmt.Errorf("%w %q of %s: %q is not %s", ErrInvalidLabel, l.Key, t, val, l.Format))
+		}
+	}
+	unknown := make([]string, 0, len(mr.Labels))
+	for key := range mr.Labels {
+		if !keys[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		errs = append(errs, fmt.Errorf("%w %q of %s", ErrUnknownLabel, key, t))
+	}
+
+	return errors.Join(errs...)
+}
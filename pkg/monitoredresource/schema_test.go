@@ -0,0 +1,234 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package monitoredresource
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	mrpb "google.golang.org/genproto/googleapis/api/monitoredres"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		typ     Type
+		labels  Label
+		want    *MonitoredResource
+		wantErr []error
+	}{
+		"Success": {
+			typ:    Global,
+			labels: Label{"project_id": projectID},
+			want: &MonitoredResource{
+				LogID: "stdout",
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type:   string(Global),
+					Labels: Label{"project_id": projectID},
+				},
+			},
+		},
+		"UnknownType": {
+			typ:     Type("unknown"),
+			labels:  Label{"project_id": projectID},
+			wantErr: []error{ErrUnknownType},
+		},
+		"MissingLabel": {
+			typ:     GCEInstance,
+			labels:  Label{"project_id": projectID, "zone": ""},
+			wantErr: []error{ErrMissingLabel},
+		},
+		"UnknownLabel": {
+			typ:     Global,
+			labels:  Label{"project_id": projectID, "zone": zoneID},
+			wantErr: []error{ErrUnknownLabel},
+		},
+		"MissingAndUnknownLabel": {
+			typ:     Global,
+			labels:  Label{"zone": zoneID},
+			wantErr: []error{ErrMissingLabel, ErrUnknownLabel},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := New(tt.typ, tt.labels)
+			for _, wantErr := range tt.wantErr {
+				if !errors.Is(err, wantErr) {
+					t.Fatalf("got %v error but want %v", err, wantErr)
+				}
+			}
+			if len(tt.wantErr) == 0 && err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreUnexported(mrpb.MonitoredResource{})); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestNewTypedResource(t *testing.T) {
+	t.Parallel()
+
+	got, err := NewK8sContainer(projectID, zoneID, clusterName, namespaceName, podName, containerName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &MonitoredResource{
+		LogID: "stdout",
+		MonitoredResource: &mrpb.MonitoredResource{
+			Type: string(K8sContainer),
+			Labels: Label{
+				"project_id":     projectID,
+				"location":       zoneID,
+				"cluster_name":   clusterName,
+				"namespace_name": namespaceName,
+				"pod_name":       podName,
+				"container_name": containerName,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(mrpb.MonitoredResource{})); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+
+	if _, err := NewDataflowStep(projectID, "job-id", "step-id", "", regionID); !errors.Is(err, ErrMissingLabel) {
+		t.Fatalf("got %v error but want %v", err, ErrMissingLabel)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		res     *MonitoredResource
+		wantErr []error
+	}{
+		"Valid": {
+			res: &MonitoredResource{MonitoredResource: &mrpb.MonitoredResource{
+				Type:   string(CloudFunction),
+				Labels: Label{"project_id": projectID, "function_name": serviceName, "region": regionID},
+			}},
+		},
+		"OptionalLabel": {
+			res: &MonitoredResource{MonitoredResource: &mrpb.MonitoredResource{
+				Type:   string(GenericNode),
+				Labels: Label{"project_id": projectID, "location": "", "node_id": instanceName},
+			}},
+		},
+		"AWSLocation": {
+			res: &MonitoredResource{MonitoredResource: &mrpb.MonitoredResource{
+				Type:   string(GenericTask),
+				Labels: Label{"project_id": projectID, "location": "aws:us-east-1a", "job": serviceName, "task_id": "0"},
+			}},
+		},
+		"Nil": {
+			res:     nil,
+			wantErr: []error{ErrUnknownType},
+		},
+		"InvalidRegion": {
+			res: &MonitoredResource{MonitoredResource: &mrpb.MonitoredResource{
+				Type:   string(CloudFunction),
+				Labels: Label{"project_id": projectID, "function_name": serviceName, "region": "test-cf-revision-001"},
+			}},
+			wantErr: []error{ErrInvalidLabel},
+		},
+		"InvalidAWSRegion": {
+			res: &MonitoredResource{MonitoredResource: &mrpb.MonitoredResource{
+				Type: string(AWSS3Bucket),
+				Labels: Label{
+					"project_id":  projectID,
+					"bucket_name": "bucket",
+					"region":      "us-east-1",
+					"aws_account": "123456789012",
+				},
+			}},
+			wantErr: []error{ErrInvalidLabel},
+		},
+		"MissingAndUnknownLabel": {
+			res: &MonitoredResource{MonitoredResource: &mrpb.MonitoredResource{
+				Type:   string(GCEInstance),
+				Labels: Label{"project_id": projectID, "zone": "us-central1", "region": regionID},
+			}},
+			wantErr: []error{ErrMissingLabel, ErrUnknownLabel, ErrInvalidLabel},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.res.Validate()
+			for _, wantErr := range tt.wantErr {
+				if !errors.Is(err, wantErr) {
+					t.Fatalf("got %v error but want %v", err, wantErr)
+				}
+			}
+			if len(tt.wantErr) == 0 && err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// TestDescriptors checks that the generated descriptors are up to date with resources.json.
+func TestDescriptors(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("resources.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resources []struct {
+		Type        Type   `json:"type"`
+		DisplayName string `json:"displayName"`
+		Description string `json:"description"`
+		Labels      []struct {
+			Key         string      `json:"key"`
+			Description string      `json:"description"`
+			Optional    bool        `json:"optional"`
+			Format      LabelFormat `json:"format"`
+		} `json:"labels"`
+	}
+	if err := json.Unmarshal(data, &resources); err != nil {
+		t.Fatal(err)
+	}
+
+	want := make([]*Descriptor, 0, len(resources))
+	for _, r := range resources {
+		desc := &Descriptor{
+			Type:        r.Type,
+			DisplayName: r.DisplayName,
+			Description: r.Description,
+		}
+		for _, l := range r.Labels {
+			desc.Labels = append(desc.Labels, LabelDescriptor{
+				Key:         l.Key,
+				Description: l.Description,
+				Optional:    l.Optional,
+				Format:      l.Format,
+			})
+		}
+		want = append(want, desc)
+	}
+
+	types := Types()
+	got := make([]*Descriptor, 0, len(types))
+	for _, typ := range types {
+		got = append(got, typ.Descriptor())
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("run go generate to update resources_gen.go: (-want, +got)\n%s\n", diff)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package slogcl
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/zchee/zapcl"
+)
+
+// zapFields is the slog value which holds the zapcl special fields as is.
+type zapFields []zapcore.Field
+
+// fieldAttr returns the slog.Attr that holds fields as is, so that the Handler writes them same as zapcl.
+func fieldAttr(key string, fields ...zapcore.Field) slog.Attr {
+	return slog.Any(key, zapFields(fields))
+}
+
+// HTTP returns the Cloud Logging "httpRequest" attribute.
+//
+// See zapcl.HTTP.
+func HTTP(req *zapcl.HTTPPayload) slog.Attr {
+	return fieldAttr(zapcl.HTTPRequestKey, zapcl.HTTP(req))
+}
+
+// Labels returns the Cloud Logging "labels" attribute from keyvals.
+//
+// See zapcl.Labels.
+func Labels(keyvals ...string) slog.Attr {
+	return fieldAttr(zapcl.LabelsKey, zapcl.Labels(keyvals...))
+}
+
+// Operation returns the Cloud Logging "operation" attribute.
+//
+// See zapcl.Operation.
+func Operation(id, producer string, first, last bool) slog.Attr {
+	return fieldAttr(zapcl.OperationKey, zapcl.Operation(id, producer, first, last))
+}
+
+// OperationStart is a convenience function for Operation.
+//
+// It should be called for the first operation log.
+func OperationStart(id, producer string) slog.Attr {
+	return Operation(id, producer, true, false)
+}
+
+// OperationCont is a convenience function for Operation.
+//
+// It should be called for any non-start/end operation log.
+func OperationCont(id, producer string) slog.Attr {
+	return Operation(id, producer, false, false)
+}
+
+// OperationEnd is a convenience function for Operation.
+//
+// It should be called for the last operation log.
+func OperationEnd(id, producer string) slog.Attr {
+	return Operation(id, producer, false, true)
+}
+
+// SourceLocation returns the Cloud Logging "sourceLocation" attribute.
+//
+// See zapcl.SourceLocation.
+func SourceLocation(pc uintptr, file string, line int, ok bool) slog.Attr {
+	return fieldAttr(zapcl.SourceLocationKey, zapcl.SourceLocation(pc, file, line, ok))
+}
+
+// Trace returns the Cloud Logging "trace", "spanId" and "trace_sampled" attributes.
+//
+// See zapcl.TraceField.
+func Trace(traceID, spanID string, isSampled bool) slog.Attr {
+	return fieldAttr(zapcl.TraceKey, zapcl.TraceField(traceID, spanID, isSampled)...)
+}
+
+// ServiceContext returns the Error Reporting "serviceContext" attribute.
+//
+// See zapcl.ServiceContext.
+func ServiceContext(name string) slog.Attr {
+	return fieldAttr("serviceContext", zapcl.ServiceContext(name))
+}
+
+// ErrorReport returns the Error Reporting "context" attribute.
+//
+// See zapcl.ErrorReport.
+func ErrorReport(pc uintptr, file string, line int, ok bool) slog.Attr {
+	return fieldAttr("context", zapcl.ErrorReport(pc, file, line, ok))
+}
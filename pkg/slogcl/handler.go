@@ -0,0 +1,260 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package slogcl provides the log/slog Handler that emits the Cloud Logging structured JSON.
+//
+// The Handler writes through the zapcl Core, so the output is identical to the zap logger created by zapcl.NewCore.
+package slogcl
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	logtypepb "google.golang.org/genproto/googleapis/logging/type"
+
+	"github.com/zchee/zapcl"
+)
+
+// List of the additional slog levels for the Cloud Logging severities which have no slog counterpart.
+const (
+	// LevelCritical is the Cloud Logging CRITICAL severity.
+	LevelCritical = slog.LevelError + 4
+
+	// LevelAlert is the Cloud Logging ALERT severity.
+	LevelAlert = slog.LevelError + 8
+
+	// LevelEmergency is the Cloud Logging EMERGENCY severity.
+	LevelEmergency = slog.LevelError + 12
+)
+
+// zapLevel converts the slog level to the zapcore.Level which has the same Cloud Logging severity.
+func zapLevel(l slog.Level) zapcore.Level {
+	switch {
+	case l < slog.LevelInfo:
+		return zapcore.DebugLevel
+	case l < slog.LevelWarn:
+		return zapcore.InfoLevel
+	case l < slog.LevelError:
+		return zapcore.WarnLevel
+	case l < LevelCritical:
+		return zapcore.ErrorLevel
+	case l < LevelAlert:
+		return zapcore.DPanicLevel
+	case l < LevelEmergency:
+		return zapcore.PanicLevel
+	}
+
+	return zapcore.FatalLevel
+}
+
+// Severity returns the Cloud Logging LogSeverity of the slog level.
+func Severity(l slog.Level) logtypepb.LogSeverity {
+	switch zapLevel(l) {
+	case zapcore.DebugLevel:
+		return logtypepb.LogSeverity_DEBUG
+	case zapcore.InfoLevel:
+		return logtypepb.LogSeverity_INFO
+	case zapcore.WarnLevel:
+		return logtypepb.LogSeverity_WARNING
+	case zapcore.ErrorLevel:
+		return logtypepb.LogSeverity_ERROR
+	case zapcore.DPanicLevel:
+		return logtypepb.LogSeverity_CRITICAL
+	case zapcore.PanicLevel:
+		return logtypepb.LogSeverity_ALERT
+	}
+
+	return logtypepb.LogSeverity_EMERGENCY
+}
+
+// HandlerOptions are options for a Handler.
+type HandlerOptions struct {
+	// AddSource causes the handler to add the "caller" field same as zap.AddCaller.
+	AddSource bool
+
+	// LoggerName is the "logger" field same as zap.Logger.Named.
+	LoggerName string
+}
+
+// Handler is the slog.Handler that writes the log records to the zapcore.Core.
+type Handler struct {
+	core   zapcore.Core
+	opts   HandlerOptions
+	groups []string // the groups which have no attributes yet
+}
+
+var _ slog.Handler = (*Handler)(nil)
+
+// NewHandler creates a Handler that writes to ws through the zapcl Core.
+func NewHandler(ws zapcore.WriteSyncer, enab zapcore.LevelEnabler, opts *HandlerOptions, coreOpts ...zapcl.Option) *Handler {
+	return NewHandlerWithCore(zapcl.NewCore(ws, enab, coreOpts...), opts)
+}
+
+// NewHandlerWithCore creates a Handler that writes to core.
+func NewHandlerWithCore(core zapcore.Core, opts *HandlerOptions) *Handler {
+	if opts == nil {
+		opts = new(HandlerOptions)
+	}
+
+	return &Handler{
+		core: core,
+		opts: *opts,
+	}
+}
+
+// Enabled reports whether the handler handles records at the given level.
+//
+// Enabled implements slog.Handler.Enabled.
+func (h *Handler) Enabled(_ context.Context, l slog.Level) bool {
+	return h.core.Enabled(zapLevel(l))
+}
+
+// Handle writes the Record to the zapcore.Core.
+//
+// Handle implements slog.Handler.Handle.
+func (h *Handler) Handle(_ context.Context, r slog.Record) error {
+	ent := zapcore.Entry{
+		Level:      zapLevel(r.Level),
+		Time:       r.Time,
+		LoggerName: h.opts.LoggerName,
+		Message:    r.Message,
+	}
+	if h.opts.AddSource && r.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{r.PC}).Next()
+		ent.Caller = zapcore.EntryCaller{
+			Defined:  frame.PC != 0,
+			PC:       frame.PC,
+			File:     frame.File,
+			Line:     frame.Line,
+			Function: frame.Function,
+		}
+	}
+
+	ce := h.core.Check(ent, nil)
+	if ce == nil {
+		return nil
+	}
+
+	fields := make([]zapcore.Field, 0, len(h.groups)+r.NumAttrs())
+	if r.NumAttrs() > 0 {
+		fields = appendGroups(fields, h.groups)
+	}
+	r.Attrs(func(attr slog.Attr) bool {
+		fields = appendAttr(fields, attr)
+		return true
+	})
+	ce.Write(fields...)
+
+	return nil
+}
+
+// WithAttrs returns a new Handler whose attributes consists of both the receiver's attributes and the arguments.
+//
+// WithAttrs implements slog.Handler.WithAttrs.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	fields := appendGroups(make([]zapcore.Field, 0, len(h.groups)+len(attrs)), h.groups)
+	for _, attr := range attrs {
+		fields = appendAttr(fields, attr)
+	}
+
+	return &Handler{
+		core: h.core.With(fields),
+		opts: h.opts,
+	}
+}
+
+// WithGroup returns a new Handler with the given group appended to the receiver's existing groups.
+//
+// WithGroup implements slog.Handler.WithGroup.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	groups := make([]string, len(h.groups), len(h.groups)+1)
+	copy(groups, h.groups)
+
+	return &Handler{
+		core:   h.core,
+		opts:   h.opts,
+		groups: append(groups, name),
+	}
+}
+
+// appendGroups opens the namespaces of groups. The empty group is omitted by opening it lazily.
+func appendGroups(fields []zapcore.Field, groups []string) []zapcore.Field {
+	for _, group := range groups {
+		fields = append(fields, zap.Namespace(group))
+	}
+
+	return fields
+}
+
+// appendAttr converts attr to the zap fields.
+func appendAttr(fields []zapcore.Field, attr slog.Attr) []zapcore.Field {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return fields
+	}
+
+	switch attr.Value.Kind() {
+	case slog.KindString:
+		return append(fields, zap.String(attr.Key, attr.Value.String()))
+	case slog.KindInt64:
+		return append(fields, zap.Int64(attr.Key, attr.Value.Int64()))
+	case slog.KindUint64:
+		return append(fields, zap.Uint64(attr.Key, attr.Value.Uint64()))
+	case slog.KindFloat64:
+		return append(fields, zap.Float64(attr.Key, attr.Value.Float64()))
+	case slog.KindBool:
+		return append(fields, zap.Bool(attr.Key, attr.Value.Bool()))
+	case slog.KindDuration:
+		return append(fields, zap.Duration(attr.Key, attr.Value.Duration()))
+	case slog.KindTime:
+		return append(fields, zap.Time(attr.Key, attr.Value.Time()))
+
+	case slog.KindGroup:
+		attrs := attr.Value.Group()
+		if len(attrs) == 0 {
+			return fields
+		}
+		if attr.Key == "" {
+			// inline the group
+			for _, a := range attrs {
+				fields = appendAttr(fields, a)
+			}
+			return fields
+		}
+		return append(fields, zap.Object(attr.Key, groupObject(attrs)))
+	}
+
+	switch v := attr.Value.Any().(type) {
+	case zapFields:
+		return append(fields, v...)
+	case error:
+		return append(fields, zap.NamedError(attr.Key, v))
+	default:
+		return append(fields, zap.Any(attr.Key, v))
+	}
+}
+
+// groupObject is the zapcore.ObjectMarshaler of the slog group attributes.
+type groupObject []slog.Attr
+
+// MarshalLogObject implements zapcore.ObjectMarshaler.
+func (attrs groupObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for _, attr := range attrs {
+		for _, field := range appendAttr(nil, attr) {
+			field.AddTo(enc)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package slogcl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	mrpb "google.golang.org/genproto/googleapis/api/monitoredres"
+	logtypepb "google.golang.org/genproto/googleapis/logging/type"
+
+	"github.com/zchee/zapcl"
+	"github.com/zchee/zapcl/pkg/monitoredresource"
+)
+
+var testTime = time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+
+// withTestResource configures the Core with the fixed resource, so the tests do not detect the resource of the
+// machine running them.
+var withTestResource = zapcl.WithMonitoredResource(&monitoredresource.MonitoredResource{
+	LogID: "stdout",
+	MonitoredResource: &mrpb.MonitoredResource{
+		Type:   string(monitoredresource.Global),
+		Labels: monitoredresource.Label{"project_id": "test-project"},
+	},
+})
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	payload := &zapcl.HTTPPayload{
+		HttpRequest: &logtypepb.HttpRequest{
+			RequestMethod: "GET",
+			Status:        200,
+		},
+	}
+
+	tests := map[string]struct {
+		handler func(h slog.Handler) slog.Handler
+		level   slog.Level
+		attrs   []slog.Attr
+		core    func(c zapcore.Core) zapcore.Core
+		zlevel  zapcore.Level
+		fields  []zapcore.Field
+	}{
+		"Primitives": {
+			level: slog.LevelInfo,
+			attrs: []slog.Attr{
+				slog.String("string", "value"),
+				slog.Int("int", 1),
+				slog.Uint64("uint", 2),
+				slog.Float64("float", 1.5),
+				slog.Bool("bool", true),
+				slog.Duration("duration", time.Second),
+				slog.Time("time", testTime),
+				slog.Any("error", errors.New("boom")),
+			},
+			zlevel: zapcore.InfoLevel,
+			fields: []zapcore.Field{
+				zap.String("string", "value"),
+				zap.Int64("int", 1),
+				zap.Uint64("uint", 2),
+				zap.Float64("float", 1.5),
+				zap.Bool("bool", true),
+				zap.Duration("duration", time.Second),
+				zap.Time("time", testTime),
+				zap.NamedError("error", errors.New("boom")),
+			},
+		},
+		"SpecialFields": {
+			level: slog.LevelError,
+			attrs: []slog.Attr{
+				HTTP(payload),
+				Labels("env", "test"),
+				OperationStart("id", "producer"),
+				ServiceContext("service"),
+			},
+			zlevel: zapcore.ErrorLevel,
+			fields: []zapcore.Field{
+				zapcl.HTTP(payload),
+				zapcl.Labels("env", "test"),
+				zapcl.OperationStart("id", "producer"),
+				zapcl.ServiceContext("service"),
+			},
+		},
+		"WithAttrsAndGroup": {
+			handler: func(h slog.Handler) slog.Handler {
+				return h.WithAttrs([]slog.Attr{slog.String("a", "b")}).WithGroup("empty").WithGroup("g")
+			},
+			level: slog.LevelWarn,
+			attrs: []slog.Attr{
+				slog.String("c", "d"),
+				slog.Group("nested", slog.Int("x", 1)),
+				slog.Group("", slog.Int("inlined", 2)),
+			},
+			core: func(c zapcore.Core) zapcore.Core {
+				return c.With([]zapcore.Field{zap.String("a", "b")})
+			},
+			zlevel: zapcore.WarnLevel,
+			fields: []zapcore.Field{
+				zap.Namespace("empty"),
+				zap.Namespace("g"),
+				zap.String("c", "d"),
+				zap.Object("nested", zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+					enc.AddInt64("x", 1)
+					return nil
+				})),
+				zap.Int64("inlined", 2),
+			},
+		},
+		"Critical": {
+			level:  LevelCritical,
+			zlevel: zapcore.DPanicLevel,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var want, got bytes.Buffer
+
+			core := zapcl.NewCore(zapcore.AddSync(&want), zapcore.DebugLevel, withTestResource)
+			if tt.core != nil {
+				core = tt.core(core)
+			}
+			if err := core.Write(zapcore.Entry{Level: tt.zlevel, Time: testTime, Message: "hello"}, tt.fields); err != nil {
+				t.Fatal(err)
+			}
+
+			var h slog.Handler = NewHandlerWithCore(zapcl.NewCore(zapcore.AddSync(&got), zapcore.DebugLevel, withTestResource), nil)
+			if tt.handler != nil {
+				h = tt.handler(h)
+			}
+			r := slog.NewRecord(testTime, tt.level, "hello", 0)
+			r.AddAttrs(tt.attrs...)
+			if err := h.Handle(context.Background(), r); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(want.String(), got.String()); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestHandlerEnabled(t *testing.T) {
+	t.Parallel()
+
+	h := NewHandlerWithCore(zapcore.NewNopCore(), nil)
+	if h.Enabled(context.Background(), slog.LevelInfo) {
+		t.Fatal("nop core should not be enabled")
+	}
+
+	h = NewHandler(zapcore.AddSync(new(bytes.Buffer)), zapcore.WarnLevel, nil, withTestResource)
+	if h.Enabled(context.Background(), slog.LevelInfo) {
+		t.Fatal("info should not be enabled")
+	}
+	if !h.Enabled(context.Background(), slog.LevelWarn) {
+		t.Fatal("warn should be enabled")
+	}
+}
+
+func TestSeverity(t *testing.T) {
+	t.Parallel()
+
+	tests := map[slog.Level]logtypepb.LogSeverity{
+		slog.LevelDebug:     logtypepb.LogSeverity_DEBUG,
+		slog.LevelInfo:      logtypepb.LogSeverity_INFO,
+		slog.LevelInfo + 2:  logtypepb.LogSeverity_INFO,
+		slog.LevelWarn:      logtypepb.LogSeverity_WARNING,
+		slog.LevelError:     logtypepb.LogSeverity_ERROR,
+		LevelCritical:       logtypepb.LogSeverity_CRITICAL,
+		LevelAlert:          logtypepb.LogSeverity_ALERT,
+		LevelEmergency:      logtypepb.LogSeverity_EMERGENCY,
+		LevelEmergency + 10: logtypepb.LogSeverity_EMERGENCY,
+	}
+	for level, want := range tests {
+		if got := Severity(level); got != want {
+			t.Errorf("Severity(%v): got %v but want %v", level, got, want)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package traceheader parses the trace context HTTP headers into the trace ID, span ID and sampled flag
+// expected by zapcl.TraceField.
+//
+// Supported headers:
+//   - X-Cloud-Trace-Context: https://cloud.google.com/trace/docs/trace-context#legacy-http-header
+//   - traceparent and tracestate: https://www.w3.org/TR/trace-context/
+package traceheader
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// List of the trace context HTTP header names.
+const (
+	// CloudTraceContextHeader is the legacy Google Cloud trace context header injected by Cloud Run and Cloud Load Balancing.
+	//
+	// The format is "TRACE_ID/SPAN_ID;o=OPTIONS", where SPAN_ID is the decimal representation of the span ID.
+	CloudTraceContextHeader = "X-Cloud-Trace-Context"
+
+	// TraceParentHeader is the W3C Trace Context traceparent header.
+	//
+	// The format is "VERSION-TRACE_ID-PARENT_ID-TRACE_FLAGS".
+	TraceParentHeader = "traceparent"
+
+	// TraceStateHeader is the W3C Trace Context tracestate header.
+	TraceStateHeader = "tracestate"
+)
+
+const (
+	traceIDLen = 32
+	spanIDLen  = 16
+
+	// maxTraceStateMembers is the maximum number of the tracestate list-members.
+	maxTraceStateMembers = 32
+)
+
+var (
+	// ErrNoTrace is returned when the request has no trace context header.
+	ErrNoTrace = errors.New("no trace context header")
+
+	// ErrMalformed is returned when the trace context header is malformed.
+	ErrMalformed = errors.New("malformed trace context header")
+)
+
+// Trace is the trace context parsed from the HTTP headers.
+type Trace struct {
+	// TraceID is the 32 lowercase hex digits trace ID.
+	TraceID string
+
+	// SpanID is the 16 lowercase hex digits span ID.
+	SpanID string
+
+	// Sampled reports whether the trace is sampled.
+	Sampled bool
+
+	// TraceState is the vendor-specific trace state of the tracestate header.
+	// It is empty if the tracestate header is missing or malformed, or the trace is not parsed from the traceparent header.
+	TraceState string
+}
+
+// FromRequest parses the trace context headers of r.
+//
+// The traceparent header takes precedence over the X-Cloud-Trace-Context header. If the traceparent header is malformed,
+// FromRequest falls back to the X-Cloud-Trace-Context header.
+func FromRequest(r *http.Request) (Trace, error) {
+	return FromHeader(r.Header)
+}
+
+// FromHeader is like FromRequest but parses h.
+func FromHeader(h http.Header) (Trace, error) {
+	var tpErr error
+	if tp := h.Get(TraceParentHeader); tp != "" {
+		t, err := ParseTraceParent(tp, strings.Join(h.Values(TraceStateHeader), ","))
+		if err == nil {
+			return t, nil
+		}
+		tpErr = err
+	}
+
+	if xctc := h.Get(CloudTraceContextHeader); xctc != "" {
+		return ParseCloudTraceContext(xctc)
+	}
+
+	if tpErr != nil {
+		return Trace{}, tpErr
+	}
+
+	return Trace{}, ErrNoTrace
+}
+
+// ParseCloudTraceContext parses the X-Cloud-Trace-Context header value.
+//
+// The decimal span ID is converted to the 16 hex digits form Cloud Logging expects.
+func ParseCloudTraceContext(s string) (Trace, error) {
+	s = strings.TrimSpace(s)
+
+	s, options, _ := strings.Cut(s, ";")
+	traceID, span, _ := strings.Cut(s, "/")
+	traceID = strings.ToLower(traceID)
+	if !isValidID(traceID, traceIDLen) {
+		return Trace{}, fmt.Errorf("%w: invalid trace ID %q", ErrMalformed, traceID)
+	}
+
+	var t Trace
+	t.TraceID = traceID
+
+	if span != "" {
+		id, err := strconv.ParseUint(span, 10, 64)
+		if err != nil || id == 0 {
+			return Trace{}, fmt.Errorf("%w: invalid span ID %q", ErrMalformed, span)
+		}
+		t.SpanID = fmt.Sprintf("%016x", id)
+	}
+
+	if options != "" {
+		opt, ok := strings.CutPrefix(options, "o=")
+		if !ok {
+			return Trace{}, fmt.Errorf("%w: invalid options %q", ErrMalformed, options)
+		}
+		switch opt {
+		case "0":
+		case "1":
+			t.Sampled = true
+		default:
+			return Trace{}, fmt.Errorf("%w: invalid options %q", ErrMalformed, options)
+		}
+	}
+
+	return t, nil
+}
+
+// ParseTraceParent parses the traceparent and tracestate header values.
+//
+// The malformed tracestate is discarded without error as the W3C Trace Context specification requires.
+func ParseTraceParent(traceparent, tracestate string) (Trace, error) {
+	traceparent = strings.TrimSpace(traceparent)
+
+	// version "-" trace-id "-" parent-id "-" trace-flags
+	const version00Len = 2 + 1 + traceIDLen + 1 + spanIDLen + 1 + 2
+	if len(traceparent) < version00Len {
+		return Trace{}, fmt.Errorf("%w: invalid traceparent length %d", ErrMalformed, len(traceparent))
+	}
+
+	version := traceparent[:2]
+	if !isHex(version) || version == "ff" {
+		return Trace{}, fmt.Errorf("%w: invalid traceparent version %q", ErrMalformed, version)
+	}
+	// the future versions may append the fields after the trace-flags
+	if len(traceparent) > version00Len && (version == "00" || traceparent[version00Len] != '-') {
+		return Trace{}, fmt.Errorf("%w: invalid traceparent length %d", ErrMalformed, len(traceparent))
+	}
+
+	parts := strings.Split(traceparent[:version00Len], "-")
+	if len(parts) != 4 {
+		return Trace{}, fmt.Errorf("%w: invalid traceparent %q", ErrMalformed, traceparent)
+	}
+	traceID, spanID, flags := parts[1], parts[2], parts[3]
+	if !isValidID(traceID, traceIDLen) {
+		return Trace{}, fmt.Errorf("%w: invalid trace ID %q", ErrMalformed, traceID)
+	}
+	if !isValidID(spanID, spanIDLen) {
+		return Trace{}, fmt.Errorf("%w: invalid parent ID %q", ErrMalformed, spanID)
+	}
+	if !isHex(flags) {
+		return Trace{}, fmt.Errorf("%w: invalid trace flags %q", ErrMalformed, flags)
+	}
+	f, _ := strconv.ParseUint(flags, 16, 8)
+
+	t := Trace{
+		TraceID: traceID,
+		SpanID:  spanID,
+		Sampled: f&0x01 == 0x01,
+	}
+	if isValidTraceState(tracestate) {
+		t.TraceState = strings.TrimSpace(tracestate)
+	}
+
+	return t, nil
+}
+
+// isValidID reports whether id is the n lowercase hex digits and not all zeros.
+func isValidID(id string, n int) bool {
+	return len(id) == n && isHex(id) && strings.Trim(id, "0") != ""
+}
+
+// isHex reports whether s consists of the lowercase hex digits.
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// isValidTraceState reports whether s is the valid tracestate list.
+//
+// https://www.w3.org/TR/trace-context/#tracestate-header-field-values
+func isValidTraceState(s string) bool {
+	if strings.TrimSpace(s) == "" {
+		return false
+	}
+
+	members := 0
+	seen := make(map[string]bool)
+	for _, member := range strings.Split(s, ",") {
+		member = strings.TrimSpace(member)
+		if member == "" {
+			// empty list-members are allowed
+			continue
+		}
+		members++
+		if members > maxTraceStateMembers {
+			return false
+		}
+
+		key, val, ok := strings.Cut(member, "=")
+		if !ok || !isValidTraceStateKey(key) || !isValidTraceStateValue(val) || seen[key] {
+			return false
+		}
+		seen[key] = true
+	}
+
+	return members > 0
+}
+
+// isValidTraceStateKey reports whether key is the valid simple-key or multi-tenant-key.
+func isValidTraceStateKey(key string) bool {
+	tenant, system, multi := strings.Cut(key, "@")
+	if multi {
+		return isValidKeyChars(tenant, 241, true) && isValidKeyChars(system, 14, false)
+	}
+
+	return isValidKeyChars(key, 256, false)
+}
+
+func isValidKeyChars(s string, maxLen int, allowDigitFirst bool) bool {
+	if s == "" || len(s) > maxLen {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z':
+		case '0' <= c && c <= '9':
+			if i == 0 && !allowDigitFirst {
+				return false
+			}
+		case i > 0 && (c == '_' || c == '-' || c == '*' || c == '/'):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// isValidTraceStateValue reports whether val consists of the printable ASCII characters except ',' and '=',
+// and does not end with a space.
+func isValidTraceStateValue(val string) bool {
+	if val == "" || len(val) > 256 || val[len(val)-1] == ' ' {
+		return false
+	}
+	for i := 0; i < len(val); i++ {
+		c := val[i]
+		if c < 0x20 || c > 0x7e || c == ',' || c == '=' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FormatTraceParent returns the traceparent header value of t.
+//
+// The span ID of t becomes the parent ID of the outgoing request.
+func FormatTraceParent(t Trace) string {
+	flags := "00"
+	if t.Sampled {
+		flags = "01"
+	}
+
+	return "00-" + t.TraceID + "-" + t.SpanID + "-" + flags
+}
+
+// FormatCloudTraceContext returns the X-Cloud-Trace-Context header value of t.
+//
+// The hex span ID of t is converted to the decimal form, and omitted if it is not valid.
+func FormatCloudTraceContext(t Trace) string {
+	s := t.TraceID
+	if id, err := strconv.ParseUint(t.SpanID, 16, 64); err == nil && id != 0 {
+		s += "/" + strconv.FormatUint(id, 10)
+	}
+	if t.Sampled {
+		return s + ";o=1"
+	}
+
+	return s + ";o=0"
+}
+
+// Inject sets the traceparent, tracestate and X-Cloud-Trace-Context headers of t to h.
+//
+// The headers which h already has are kept as is. The traceparent header is set only if t has the valid span ID.
+func Inject(h http.Header, t Trace) {
+	if !isValidID(t.TraceID, traceIDLen) {
+		return
+	}
+
+	if h.Get(TraceParentHeader) == "" && isValidID(t.SpanID, spanIDLen) {
+		h.Set(TraceParentHeader, FormatTraceParent(t))
+		if t.TraceState != "" && h.Get(TraceStateHeader) == "" {
+			h.Set(TraceStateHeader, t.TraceState)
+		}
+	}
+	if h.Get(CloudTraceContextHeader) == "" {
+		h.Set(CloudTraceContextHeader, FormatCloudTraceContext(t))
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package traceheader
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseCloudTraceContext(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		header  string
+		want    Trace
+		wantErr bool
+	}{
+		"Sampled": {
+			header: "105445aa7843bc8bf206b12000100000/1;o=1",
+			want: Trace{
+				TraceID: "105445aa7843bc8bf206b12000100000",
+				SpanID:  "0000000000000001",
+				Sampled: true,
+			},
+		},
+		"NotSampled": {
+			header: "105445aa7843bc8bf206b12000100000/18446744073709551615;o=0",
+			want: Trace{
+				TraceID: "105445aa7843bc8bf206b12000100000",
+				SpanID:  "ffffffffffffffff",
+			},
+		},
+		"NoOptions": {
+			header: "105445aa7843bc8bf206b12000100000/2706056406893913",
+			want: Trace{
+				TraceID: "105445aa7843bc8bf206b12000100000",
+				SpanID:  "00099d24dae00559",
+			},
+		},
+		"TraceIDOnly": {
+			header: "105445AA7843BC8BF206B12000100000",
+			want: Trace{
+				TraceID: "105445aa7843bc8bf206b12000100000",
+			},
+		},
+		"NoSpanID": {
+			header: "105445aa7843bc8bf206b12000100000;o=1",
+			want: Trace{
+				TraceID: "105445aa7843bc8bf206b12000100000",
+				Sampled: true,
+			},
+		},
+		"ShortTraceID": {
+			header:  "105445aa7843bc8b/1;o=1",
+			wantErr: true,
+		},
+		"ZeroTraceID": {
+			header:  "00000000000000000000000000000000/1;o=1",
+			wantErr: true,
+		},
+		"HexSpanID": {
+			header:  "105445aa7843bc8bf206b12000100000/0123456789abcdef;o=1",
+			wantErr: true,
+		},
+		"OverflowSpanID": {
+			header:  "105445aa7843bc8bf206b12000100000/18446744073709551616;o=1",
+			wantErr: true,
+		},
+		"InvalidOptions": {
+			header:  "105445aa7843bc8bf206b12000100000/1;sampled=1",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseCloudTraceContext(tt.header)
+			if tt.wantErr {
+				if !errors.Is(err, ErrMalformed) {
+					t.Fatalf("got %v error but want %v", err, ErrMalformed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestParseTraceParent(t *testing.T) {
+	t.Parallel()
+
+	members := make([]string, maxTraceStateMembers+1)
+	for i := range members {
+		members[i] = fmt.Sprintf("key%d=value", i)
+	}
+	tooManyTraceState := strings.Join(members, ",")
+
+	tests := map[string]struct {
+		traceparent string
+		tracestate  string
+		want        Trace
+		wantErr     bool
+	}{
+		"Sampled": {
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			tracestate:  "rojo=00f067aa0ba902b7, congo=t61rcWkgMzE",
+			want: Trace{
+				TraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+				SpanID:     "00f067aa0ba902b7",
+				Sampled:    true,
+				TraceState: "rojo=00f067aa0ba902b7, congo=t61rcWkgMzE",
+			},
+		},
+		"NotSampled": {
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+			want: Trace{
+				TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+				SpanID:  "00f067aa0ba902b7",
+			},
+		},
+		"FutureVersion": {
+			traceparent: "cc-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-09-what-the-future-will-be-like",
+			want: Trace{
+				TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+				SpanID:  "00f067aa0ba902b7",
+				Sampled: true,
+			},
+		},
+		"MultiTenantTraceState": {
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			tracestate:  "fw529a3039@dt=ZGFkYTJmMjQ",
+			want: Trace{
+				TraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+				SpanID:     "00f067aa0ba902b7",
+				Sampled:    true,
+				TraceState: "fw529a3039@dt=ZGFkYTJmMjQ",
+			},
+		},
+		"MalformedTraceState": {
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			tracestate:  "rojo=00f067aa0ba902b7,rojo=duplicated",
+			want: Trace{
+				TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+				SpanID:  "00f067aa0ba902b7",
+				Sampled: true,
+			},
+		},
+		"TooManyTraceState": {
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			tracestate:  tooManyTraceState,
+			want: Trace{
+				TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+				SpanID:  "00f067aa0ba902b7",
+				Sampled: true,
+			},
+		},
+		"InvalidVersion": {
+			traceparent: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantErr:     true,
+		},
+		"Version00WithSuffix": {
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+			wantErr:     true,
+		},
+		"UppercaseTraceID": {
+			traceparent: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+			wantErr:     true,
+		},
+		"ZeroTraceID": {
+			traceparent: "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			wantErr:     true,
+		},
+		"ZeroParentID": {
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+			wantErr:     true,
+		},
+		"InvalidFlags": {
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-0x",
+			wantErr:     true,
+		},
+		"InvalidDelimiter": {
+			traceparent: "00_4bf92f3577b34da6a3ce929d0e0e4736_00f067aa0ba902b7_01",
+			wantErr:     true,
+		},
+		"Short": {
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+			wantErr:     true,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseTraceParent(tt.traceparent, tt.tracestate)
+			if tt.wantErr {
+				if !errors.Is(err, ErrMalformed) {
+					t.Fatalf("got %v error but want %v", err, ErrMalformed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	t.Parallel()
+
+	const (
+		traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+		xctc        = "105445aa7843bc8bf206b12000100000/1;o=0"
+	)
+
+	tests := map[string]struct {
+		header  map[string][]string
+		want    Trace
+		wantErr error
+	}{
+		"TraceParentPrecedence": {
+			header: map[string][]string{
+				TraceParentHeader:       {traceparent},
+				TraceStateHeader:        {"rojo=00f067aa0ba902b7", "congo=t61rcWkgMzE"},
+				CloudTraceContextHeader: {xctc},
+			},
+			want: Trace{
+				TraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+				SpanID:     "00f067aa0ba902b7",
+				Sampled:    true,
+				TraceState: "rojo=00f067aa0ba902b7,congo=t61rcWkgMzE",
+			},
+		},
+		"FallbackToCloudTraceContext": {
+			header: map[string][]string{
+				TraceParentHeader:       {"malformed"},
+				CloudTraceContextHeader: {xctc},
+			},
+			want: Trace{
+				TraceID: "105445aa7843bc8bf206b12000100000",
+				SpanID:  "0000000000000001",
+			},
+		},
+		"MalformedTraceParent": {
+			header: map[string][]string{
+				TraceParentHeader: {"malformed"},
+			},
+			wantErr: ErrMalformed,
+		},
+		"NoTrace": {
+			wantErr: ErrNoTrace,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest("GET", "/", nil)
+			for k, vals := range tt.header {
+				for _, v := range vals {
+					req.Header.Add(k, v)
+				}
+			}
+
+			got, err := FromRequest(req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got %v error but want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestInject(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		header http.Header
+		trace  Trace
+		want   http.Header
+	}{
+		"Sampled": {
+			header: http.Header{},
+			trace: Trace{
+				TraceID:    "4bf92f3577b34da6a3ce929d0e0e4736",
+				SpanID:     "00f067aa0ba902b7",
+				Sampled:    true,
+				TraceState: "rojo=00f067aa0ba902b7",
+			},
+			want: http.Header{
+				"Traceparent":           {"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+				"Tracestate":            {"rojo=00f067aa0ba902b7"},
+				"X-Cloud-Trace-Context": {"4bf92f3577b34da6a3ce929d0e0e4736/67667974448284343;o=1"},
+			},
+		},
+		"NoSpanID": {
+			header: http.Header{},
+			trace: Trace{
+				TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			},
+			want: http.Header{
+				"X-Cloud-Trace-Context": {"4bf92f3577b34da6a3ce929d0e0e4736;o=0"},
+			},
+		},
+		"KeepExisting": {
+			header: http.Header{
+				"Traceparent": {"00-105445aa7843bc8bf206b12000100000-0000000000000001-00"},
+			},
+			trace: Trace{
+				TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+				SpanID:  "00f067aa0ba902b7",
+			},
+			want: http.Header{
+				"Traceparent":           {"00-105445aa7843bc8bf206b12000100000-0000000000000001-00"},
+				"X-Cloud-Trace-Context": {"4bf92f3577b34da6a3ce929d0e0e4736/67667974448284343;o=0"},
+			},
+		},
+		"InvalidTraceID": {
+			header: http.Header{},
+			trace: Trace{
+				TraceID: "invalid",
+			},
+			want: http.Header{},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			Inject(tt.header, tt.trace)
+			if diff := cmp.Diff(tt.want, tt.header); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+
+			// the injected headers must round-trip
+			if _, err := FromHeader(tt.header); len(tt.want) > 0 && err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"cloud.google.com/go/logging/apiv2/loggingpb"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// recoverConfig is the configuration of the panic recovery helpers.
+type recoverConfig struct {
+	repanic bool
+	fields  []zapcore.Field
+}
+
+// RecoverOption configures RecoverAndReport, RecoverHandler and Go.
+type RecoverOption interface {
+	applyRecover(*recoverConfig)
+}
+
+// recoverOptionFunc wraps a func so it satisfies the RecoverOption interface.
+type recoverOptionFunc func(*recoverConfig)
+
+func (f recoverOptionFunc) applyRecover(c *recoverConfig) {
+	f(c)
+}
+
+// WithRepanic configures to panic again with the recovered value after the panic is reported.
+func WithRepanic() RecoverOption {
+	return recoverOptionFunc(func(c *recoverConfig) {
+		c.repanic = true
+	})
+}
+
+// WithRecoverFields configures the additional fields of the reported entry, such as ServiceContext.
+func WithRecoverFields(fields ...zapcore.Field) RecoverOption {
+	return recoverOptionFunc(func(c *recoverConfig) {
+		c.fields = append(c.fields, fields...)
+	})
+}
+
+func newRecoverConfig(opts []RecoverOption) *recoverConfig {
+	cfg := new(recoverConfig)
+	for _, opt := range opts {
+		opt.applyRecover(cfg)
+	}
+
+	return cfg
+}
+
+// RecoverAndReport recovers the panic and reports it to Error Reporting through logger.
+//
+// It must be called directly by defer:
+//
+//	defer zapcl.RecoverAndReport(logger)
+//
+// The panic is logged at ErrorLevel with the goroutine stack trace in the Go panic format, the "context.reportLocation"
+// field of the panicking function, and the trace fields of the panicking goroutine if any. Then the logger is synced
+// before RecoverAndReport returns or re-panics by WithRepanic.
+func RecoverAndReport(logger *zap.Logger, opts ...RecoverOption) {
+	v := recover()
+	if v == nil {
+		return
+	}
+
+	cfg := newRecoverConfig(opts)
+	reportPanic(context.Background(), logger, v, debug.Stack(), nil, cfg)
+	if cfg.repanic {
+		panic(v)
+	}
+}
+
+// RecoverHandler returns the http.Handler which recovers the panic of next and reports it same as RecoverAndReport.
+//
+// The reported entry also has the "context.httpRequest" field and the trace fields of the in-flight request.
+// If WithRepanic is not given, RecoverHandler responds 500 Internal Server Error instead.
+//
+// The http.ErrAbortHandler panic is not reported and always re-panicked, as net/http uses it to abort the response.
+func RecoverHandler(logger *zap.Logger, next http.Handler, opts ...RecoverOption) http.Handler {
+	cfg := newRecoverConfig(opts)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &responseWriter{ResponseWriter: w}
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if v == http.ErrAbortHandler { //nolint:errorlint,goerr113 // net/http compares it as is
+				panic(v)
+			}
+
+			reportPanic(r.Context(), logger, v, debug.Stack(), &errorHTTPRequest{
+				method:     r.Method,
+				url:        requestURL(r),
+				userAgent:  r.UserAgent(),
+				referrer:   r.Referer(),
+				remoteIP:   r.RemoteAddr,
+				statusCode: http.StatusInternalServerError,
+			}, cfg)
+			if cfg.repanic {
+				panic(v)
+			}
+
+			if rw.status == 0 && !rw.hijacked {
+				http.Error(rw, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+		}()
+
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// Go runs fn in the new goroutine which recovers the panic of fn and reports it same as RecoverAndReport.
+func Go(logger *zap.Logger, fn func(), opts ...RecoverOption) {
+	go func() {
+		defer RecoverAndReport(logger, opts...)
+		fn()
+	}()
+}
+
+// reportPanic logs the recovered panic value v with stack.
+func reportPanic(ctx context.Context, logger *zap.Logger, v interface{}, stack []byte, req *errorHTTPRequest, cfg *recoverConfig) {
+	// the stack trace of the recovery site is replaced by the panic stack trace
+	logger = logger.WithOptions(zap.AddStacktrace(zap.LevelEnablerFunc(func(zapcore.Level) bool { return false })))
+
+	msg := fmt.Sprintf("panic: %v", v)
+	if err, ok := v.(error); ok {
+		msg = "panic: " + err.Error()
+	}
+
+	if ce := logger.Check(zapcore.ErrorLevel, msg); ce != nil {
+		errCtx := &errorContext{httpRequest: req}
+		if loc, ok := panicLocation(); ok {
+			errCtx.reportLocation = &reportLocation{LogEntrySourceLocation: loc}
+		}
+
+		fields := make([]zapcore.Field, 0, 6+len(cfg.fields))
+		fields = append(fields,
+			zap.String(ErrorReportTypeKey, ReportedErrorEventType),
+			zap.String(StackTraceKey, msg+"\n\n"+chopStack(stack)),
+			zap.Object(contextKey, errCtx),
+		)
+		fields = append(fields, TraceFromContext(ctx)...)
+		fields = append(fields, cfg.fields...)
+		ce.Write(fields...)
+	}
+
+	logger.Sync() //nolint:errcheck // the process may be crashing
+}
+
+// panicLocation returns the source location of the function which called panic.
+//
+// It must be called by the deferred function which recovers the panic.
+func panicLocation() (*loggingpb.LogEntrySourceLocation, bool) {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	panicking := false
+	for {
+		frame, more := frames.Next()
+		switch {
+		case frame.Function == "runtime.gopanic":
+			panicking = true
+		case panicking && !strings.HasPrefix(frame.Function, "runtime."):
+			// skip the runtime frames of the runtime error panic, such as runtime.panicmem
+			return &loggingpb.LogEntrySourceLocation{
+				File:     frame.File,
+				Line:     int64(frame.Line),
+				Function: frame.Function,
+			}, true
+		}
+		if !more {
+			return nil, false
+		}
+	}
+}
+
+// chopStack removes the frames of the recovery itself from the debug.Stack output, so that the stack trace starts
+// from the function which called panic same as the Go panic output.
+//
+// This code was borrowed from:
+//   - https://github.com/googleapis/google-cloud-go/blob/errorreporting/v0.3.0/errorreporting/errors.go#L230-L252
+func chopStack(s []byte) string {
+	f := []byte("\npanic(")
+
+	lfFirst := bytes.IndexByte(s, '\n')
+	if lfFirst == -1 {
+		return string(s)
+	}
+	stack := s[lfFirst:]
+	panicLine := bytes.Index(stack, f)
+	if panicLine == -1 {
+		return string(s)
+	}
+
+	// skip the panic call and its source location lines
+	stack = stack[panicLine+1:]
+	for i := 0; i < 2; i++ {
+		nextLine := bytes.IndexByte(stack, '\n')
+		if nextLine == -1 {
+			return string(s)
+		}
+		stack = stack[nextLine+1:]
+	}
+
+	return string(s[:lfFirst+1]) + string(stack)
+}
+
+// errorContext is the Error Reporting ErrorContext of the recovered panic.
+//
+// ErrorContext: https://cloud.google.com/error-reporting/reference/rest/v1beta1/ErrorContext
+type errorContext struct {
+	httpRequest    *errorHTTPRequest
+	reportLocation *reportLocation
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaller.MarshalLogObject.
+func (c *errorContext) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if c.httpRequest != nil {
+		if err := enc.AddObject("httpRequest", c.httpRequest); err != nil {
+			return err
+		}
+	}
+	if c.reportLocation != nil {
+		return enc.AddObject("reportLocation", c.reportLocation)
+	}
+
+	return nil
+}
+
+// errorHTTPRequest is the Error Reporting HttpRequestContext.
+//
+// HttpRequestContext: https://cloud.google.com/error-reporting/reference/rest/v1beta1/ErrorContext#HttpRequestContext
+type errorHTTPRequest struct {
+	method     string
+	url        string
+	userAgent  string
+	referrer   string
+	remoteIP   string
+	statusCode int
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaller.MarshalLogObject.
+func (r *errorHTTPRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("method", r.method)
+	enc.AddString("url", r.url)
+	enc.AddString("userAgent", r.userAgent)
+	enc.AddString("referrer", r.referrer)
+	enc.AddInt("responseStatusCode", r.statusCode)
+	enc.AddString("remoteIp", r.remoteIP)
+
+	return nil
+}
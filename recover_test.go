@@ -0,0 +1,210 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+//go:noinline
+func panicking() {
+	panic(errors.New("boom"))
+}
+
+func TestRecoverAndReport(t *testing.T) {
+	t.Parallel()
+
+	obs, logs := observer.New(zapcore.DebugLevel)
+	logger := zap.New(obs)
+
+	func() {
+		defer RecoverAndReport(logger, WithRecoverFields(ServiceContext("test")))
+		panicking()
+	}()
+
+	entries := logs.AllUntimed()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries but want 1", len(entries))
+	}
+	ent := entries[0]
+	if ent.Level != zapcore.ErrorLevel {
+		t.Fatalf("got %v level but want %v", ent.Level, zapcore.ErrorLevel)
+	}
+	if got, want := ent.Message, "panic: boom"; got != want {
+		t.Fatalf("got %q message but want %q", got, want)
+	}
+
+	fields := ent.ContextMap()
+	if got := fields[ErrorReportTypeKey]; got != ReportedErrorEventType {
+		t.Fatalf("got %v type but want %v", got, ReportedErrorEventType)
+	}
+
+	// the stack trace starts from the panicking function same as the Go panic output
+	lines := strings.Split(fields[StackTraceKey].(string), "\n")
+	if len(lines) < 4 {
+		t.Fatalf("stack trace is too short: %q", lines)
+	}
+	if got, want := lines[0], "panic: boom"; got != want {
+		t.Fatalf("got %q but want %q", got, want)
+	}
+	if !strings.HasPrefix(lines[2], "goroutine ") || !strings.HasSuffix(lines[2], "[running]:") {
+		t.Fatalf("got %q but want the goroutine header", lines[2])
+	}
+	if !strings.HasPrefix(lines[3], "github.com/zchee/zapcl.panicking(") {
+		t.Fatalf("got %q but want the panicking frame", lines[3])
+	}
+
+	loc := fields[contextKey].(map[string]interface{})["reportLocation"].(map[string]interface{})
+	if got, want := loc["functionName"], "github.com/zchee/zapcl.panicking"; got != want {
+		t.Fatalf("got %v function but want %v", got, want)
+	}
+	if got := loc["filePath"].(string); !strings.HasSuffix(got, "recover_test.go") {
+		t.Fatalf("got %v file but want recover_test.go", got)
+	}
+
+	if _, ok := fields[serviceContextKey]; !ok {
+		t.Fatal("entry should have the recover fields")
+	}
+}
+
+func TestRecoverAndReportErrorReportEncoder(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := zap.New(NewCore(zapcore.AddSync(&buf), zapcore.DebugLevel, WithErrorReport()),
+		zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel))
+
+	func() {
+		defer RecoverAndReport(logger)
+		panicking()
+	}()
+
+	// the encoder should keep the fields of the recovered panic as is
+	for _, key := range []string{ErrorReportTypeKey, StackTraceKey, contextKey} {
+		if got := strings.Count(buf.String(), strconv.Quote(key)+":"); got != 1 {
+			t.Fatalf("got %d %s keys but want 1:\n%s", got, key, buf.String())
+		}
+	}
+	if strings.Contains(buf.String(), `"stacktrace":`) {
+		t.Fatalf("zap stack trace of the recovery site should not be encoded:\n%s", buf.String())
+	}
+}
+
+func TestRecoverAndReportRepanic(t *testing.T) {
+	t.Parallel()
+
+	obs, logs := observer.New(zapcore.DebugLevel)
+	logger := zap.New(obs)
+
+	var repanicked interface{}
+	func() {
+		defer func() { repanicked = recover() }()
+		defer RecoverAndReport(logger, WithRepanic())
+		panic("boom")
+	}()
+
+	if repanicked != "boom" {
+		t.Fatalf("got %v but want re-panic with boom", repanicked)
+	}
+	if logs.Len() != 1 {
+		t.Fatalf("got %d entries but want 1", logs.Len())
+	}
+}
+
+func TestRecoverHandler(t *testing.T) {
+	t.Parallel()
+
+	obs, logs := observer.New(zapcore.DebugLevel)
+	h := RecoverHandler(zap.New(obs), http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panicking()
+	}))
+
+	req := httptest.NewRequest("GET", "http://example.com/panic", nil)
+	req.RemoteAddr = "192.0.2.1:1234"
+	req.Header.Set("User-Agent", "test-agent")
+	req = req.WithContext(ContextWithTrace(req.Context(), "4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7", true))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("got %d status but want %d", rec.Code, http.StatusInternalServerError)
+	}
+
+	entries := logs.AllUntimed()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries but want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+
+	want := map[string]interface{}{
+		"method":             "GET",
+		"url":                "http://example.com/panic",
+		"userAgent":          "test-agent",
+		"referrer":           "",
+		"responseStatusCode": http.StatusInternalServerError,
+		"remoteIp":           "192.0.2.1:1234",
+	}
+	if diff := cmp.Diff(want, fields[contextKey].(map[string]interface{})["httpRequest"]); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+	if got, want := fields[SpanKey], "00f067aa0ba902b7"; got != want {
+		t.Fatalf("got %v span but want %v", got, want)
+	}
+}
+
+func TestRecoverHandlerAbort(t *testing.T) {
+	t.Parallel()
+
+	obs, logs := observer.New(zapcore.DebugLevel)
+	h := RecoverHandler(zap.New(obs), http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	var repanicked interface{}
+	func() {
+		defer func() { repanicked = recover() }()
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	}()
+
+	if repanicked != http.ErrAbortHandler { //nolint:errorlint,goerr113
+		t.Fatalf("got %v but want %v", repanicked, http.ErrAbortHandler)
+	}
+	if logs.Len() != 0 {
+		t.Fatal("http.ErrAbortHandler should not be reported")
+	}
+}
+
+func TestGo(t *testing.T) {
+	t.Parallel()
+
+	obs, logs := observer.New(zapcore.DebugLevel)
+	Go(zap.New(obs), panicking)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for logs.Len() == 0 {
+		select {
+		case <-ctx.Done():
+			t.Fatal("panic was not reported")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	if got, want := logs.AllUntimed()[0].Message, "panic: boom"; got != want {
+		t.Fatalf("got %q message but want %q", got, want)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/zchee/zapcl/pkg/monitoredresource"
+)
+
+// ResourceKey is the key of the MonitoredResource which the entries are attributed to.
+//
+// The value is the object which has the "type", "labels" and "logId" of the MonitoredResource.
+const ResourceKey = "resource"
+
+// resourceObject is the zapcore.ObjectMarshaler of the MonitoredResource under ResourceKey.
+type resourceObject struct {
+	res   *monitoredresource.MonitoredResource
+	logID string
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler.
+func (r resourceObject) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("type", r.res.GetType())
+	if err := enc.AddObject("labels", r.res); err != nil {
+		return err
+	}
+	if r.logID != "" {
+		enc.AddString("logId", r.logID)
+	}
+
+	return nil
+}
+
+// WithMonitoredResource configures the MonitoredResource which the entries are attributed to.
+//
+// It takes precedence over WithResourceDetector. If not set, the resource is detected by monitoredresource.Detect.
+// If res is invalid, the validation error is reported to the hook of WithResourceErrorHook, and the resource is
+// detected instead.
+func WithMonitoredResource(res *monitoredresource.MonitoredResource) Option {
+	return optionFunc(func(c *Core) {
+		c.res = res
+	})
+}
+
+// WithLogID configures the log ID of the entries, which overrides the LogID of the MonitoredResource.
+func WithLogID(logID string) Option {
+	return optionFunc(func(c *Core) {
+		c.logID = logID
+	})
+}
+
+// WithResourceDetector configures the func which detects the MonitoredResource the entries are attributed to.
+//
+// The detection is bounded by monitoredresource.DefaultResolveTimeout. If detect returns the error, the nil resource
+// or the invalid resource, the error is reported to the hook of WithResourceErrorHook, and the resource is detected by
+// monitoredresource.Detect instead.
+func WithResourceDetector(detect func(ctx context.Context) (*monitoredresource.MonitoredResource, error)) Option {
+	return optionFunc(func(c *Core) {
+		c.detectResource = detect
+	})
+}
+
+// WithResourceErrorHook configures the hook which is called with the error of the monitored resource detection,
+// such as the validation error of the detected resource.
+//
+// The entries are attributed to the resource detected by monitoredresource.Detect in that case, which is its fallback
+// resource if the platform specific resource is not detected or invalid.
+func WithResourceErrorHook(hook func(error)) Option {
+	return optionFunc(func(c *Core) {
+		c.resourceErrorHook = hook
+	})
+}
+
+// resource returns the valid MonitoredResource configured by the options, or detected by monitoredresource.Detect.
+func (c *Core) resource() *monitoredresource.MonitoredResource {
+	if c.res != nil {
+		err := c.res.Validate()
+		if err == nil {
+			return c.res
+		}
+		c.reportResourceError(fmt.Errorf("invalid monitored resource: %w", err))
+	}
+
+	if c.detectResource != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), monitoredresource.DefaultResolveTimeout)
+		defer cancel()
+
+		res, err := c.detectResource(ctx)
+		if err == nil && res == nil {
+			err = errors.New("detector returns nil")
+		}
+		if err == nil {
+			if err = res.Validate(); err == nil {
+				return res
+			}
+			err = fmt.Errorf("invalid monitored resource: %w", err)
+		}
+		c.reportResourceError(fmt.Errorf("could not detect the monitored resource: %w", err))
+	}
+
+	return monitoredresource.Detect(monitoredresource.WithErrorHook(c.resourceErrorHook))
+}
+
+// reportResourceError calls the hook of WithResourceErrorHook with err if it is configured.
+func (c *Core) reportResourceError(err error) {
+	if c.resourceErrorHook != nil {
+		c.resourceErrorHook(err)
+	}
+}
@@ -4,8 +4,15 @@
 package zapcl
 
 import (
+	"os"
+	"path/filepath"
+
 	"go.uber.org/zap"
+	"go.uber.org/zap/buffer"
 	"go.uber.org/zap/zapcore"
+
+	"github.com/zchee/zapcl/pkg/detector"
+	"github.com/zchee/zapcl/pkg/monitoredresource"
 )
 
 const (
@@ -23,3 +30,125 @@ func ServiceContext(name string) zap.Field {
 		return nil
 	}))
 }
+
+// serviceContext is the Error Reporting ServiceContext.
+type serviceContext struct {
+	service      string
+	version      string
+	resourceType string
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaller.MarshalLogObject.
+func (sc *serviceContext) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("service", sc.service)
+	if sc.version != "" {
+		enc.AddString("version", sc.version)
+	}
+	if sc.resourceType != "" {
+		enc.AddString("resourceType", sc.resourceType)
+	}
+
+	return nil
+}
+
+// ServiceContextFromResource adds the service information of the monitored resource res.
+//
+// The service and the version are taken from:
+//   - cloud_run_revision: the service_name and revision_name labels
+//   - cloud_run_job: the job_name label and CLOUD_RUN_EXECUTION
+//   - cloud_function: the function_name label and K_REVISION
+//   - gae_app: the module_id and version_id labels
+//   - k8s_container: the container_name label
+//
+// The service of the other resources is the base name of the executable. The resourceType is the type of res.
+func ServiceContextFromResource(res *monitoredresource.MonitoredResource) zap.Field {
+	sc := new(serviceContext)
+
+	labels := res.GetLabels()
+	switch monitoredresource.Type(res.GetType()) {
+	case monitoredresource.CloudRunRevision:
+		sc.service, sc.version = labels["service_name"], labels["revision_name"]
+	case monitoredresource.CloudRunJob:
+		sc.service, sc.version = labels["job_name"], os.Getenv(detector.EnvCloudRunJobsRevision)
+	case monitoredresource.CloudFunction:
+		sc.service, sc.version = labels["function_name"], os.Getenv(detector.EnvCloudFunctionsKRevision)
+	case monitoredresource.GAEApp:
+		sc.service, sc.version = labels["module_id"], labels["version_id"]
+	case monitoredresource.K8sContainer:
+		sc.service = labels["container_name"]
+	}
+	if sc.service == "" {
+		sc.service = filepath.Base(os.Args[0])
+	}
+	sc.resourceType = res.GetType()
+
+	return zap.Object(serviceContextKey, sc)
+}
+
+// WithServiceContext configures the Core to add the ServiceContextFromResource field of the detected monitored
+// resource to the entries at and above ErrorLevel.
+//
+// The field is encoded at the top level even after zap.Namespace. The entry which already has the ServiceContext field
+// at the log site or by zap.Logger.With is encoded as is.
+func WithServiceContext() Option {
+	return optionFunc(func(c *Core) {
+		c.serviceContext = true
+	})
+}
+
+// levelFieldEncoder is the zapcore.Encoder which adds the field to the entries at and above the level.
+type levelFieldEncoder struct {
+	zapcore.Encoder
+
+	level zapcore.LevelEnabler
+	field zapcore.Field
+
+	// hasField reports whether the field of the same key is already added by zap.Logger.With
+	hasField bool
+}
+
+// Clone implements zapcore.Encoder.
+func (e *levelFieldEncoder) Clone() zapcore.Encoder {
+	return &levelFieldEncoder{
+		Encoder:  e.Encoder.Clone(),
+		level:    e.level,
+		field:    e.field,
+		hasField: e.hasField,
+	}
+}
+
+// AddObject implements zapcore.ObjectEncoder.
+func (e *levelFieldEncoder) AddObject(key string, obj zapcore.ObjectMarshaler) error {
+	if key == e.field.Key {
+		e.hasField = true
+	}
+
+	return e.Encoder.AddObject(key, obj)
+}
+
+// AddReflected implements zapcore.ObjectEncoder.
+func (e *levelFieldEncoder) AddReflected(key string, val interface{}) error {
+	if key == e.field.Key {
+		e.hasField = true
+	}
+
+	return e.Encoder.AddReflected(key, val)
+}
+
+// EncodeEntry implements zapcore.Encoder.
+func (e *levelFieldEncoder) EncodeEntry(ent zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
+	if !e.level.Enabled(ent.Level) || e.hasField {
+		return e.Encoder.EncodeEntry(ent, fields)
+	}
+	for i := range fields {
+		if fields[i].Key == e.field.Key {
+			return e.Encoder.EncodeEntry(ent, fields)
+		}
+	}
+
+	extra := make([]zapcore.Field, 0, len(fields)+1)
+	extra = append(extra, fields...)
+	extra = append(extra, topLevel(e.field))
+
+	return e.Encoder.EncodeEntry(ent, extra)
+}
@@ -4,12 +4,21 @@
 package zapcl
 
 import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	mrpb "google.golang.org/genproto/googleapis/api/monitoredres"
+
+	"github.com/zchee/zapcl/pkg/detector"
+	"github.com/zchee/zapcl/pkg/monitoredresource"
 )
 
 func TestServiceContext(t *testing.T) {
@@ -26,3 +35,170 @@ func TestServiceContext(t *testing.T) {
 		t.Fatalf("(-want, +got)\n%s\n", diff)
 	}
 }
+
+func TestServiceContextFromResource(t *testing.T) {
+	t.Setenv(detector.EnvCloudFunctionsKRevision, "function-00001")
+
+	executable := filepath.Base(os.Args[0])
+
+	tests := map[string]struct {
+		res  *monitoredresource.MonitoredResource
+		want map[string]interface{}
+	}{
+		"CloudRunRevision": {
+			res: &monitoredresource.MonitoredResource{
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: string(monitoredresource.CloudRunRevision),
+					Labels: map[string]string{
+						"service_name":  "service",
+						"revision_name": "service-00001",
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"service":      "service",
+				"version":      "service-00001",
+				"resourceType": "cloud_run_revision",
+			},
+		},
+		"CloudFunction": {
+			res: &monitoredresource.MonitoredResource{
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: string(monitoredresource.CloudFunction),
+					Labels: map[string]string{
+						"function_name": "function",
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"service":      "function",
+				"version":      "function-00001",
+				"resourceType": "cloud_function",
+			},
+		},
+		"GAEApp": {
+			res: &monitoredresource.MonitoredResource{
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: string(monitoredresource.GAEApp),
+					Labels: map[string]string{
+						"module_id":  "default",
+						"version_id": "20230701t120000",
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"service":      "default",
+				"version":      "20230701t120000",
+				"resourceType": "gae_app",
+			},
+		},
+		"K8sContainer": {
+			res: &monitoredresource.MonitoredResource{
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: string(monitoredresource.K8sContainer),
+					Labels: map[string]string{
+						"container_name": "app",
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"service":      "app",
+				"resourceType": "k8s_container",
+			},
+		},
+		"Global": {
+			res: &monitoredresource.MonitoredResource{
+				MonitoredResource: &mrpb.MonitoredResource{
+					Type: string(monitoredresource.Global),
+				},
+			},
+			want: map[string]interface{}{
+				"service":      executable,
+				"resourceType": "global",
+			},
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			enc := zapcore.NewMapObjectEncoder()
+			ServiceContextFromResource(tt.res).AddTo(enc)
+
+			if diff := cmp.Diff(tt.want, enc.Fields[serviceContextKey]); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestWithServiceContext(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := zap.New(NewCore(zapcore.AddSync(&buf), zapcore.DebugLevel, WithServiceContext()))
+
+	logger.Info("info")
+	logger.Error("error")
+	logger.Error("explicit", ServiceContext("explicit"))
+	logger.With(ServiceContext("with")).Error("with")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %d lines but want 4", len(lines))
+	}
+
+	want := []interface{}{
+		nil,
+		map[string]interface{}{
+			"service":      filepath.Base(os.Args[0]),
+			"resourceType": "global",
+		},
+		map[string]interface{}{
+			"service": "explicit",
+		},
+		map[string]interface{}{
+			"service": "with",
+		},
+	}
+	for i, line := range lines {
+		if n := strings.Count(line, `"`+serviceContextKey+`"`); n > 1 {
+			t.Fatalf("line %d: got %d %s keys but want at most 1: %s", i, n, serviceContextKey, line)
+		}
+		var got map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(want[i], got[serviceContextKey]); diff != "" {
+			t.Fatalf("line %d: (-want, +got)\n%s\n", i, diff)
+		}
+	}
+}
+
+func TestWithServiceContextNamespace(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := zap.New(NewCore(zapcore.AddSync(&buf), zapcore.DebugLevel, WithServiceContext()))
+
+	logger.With(zap.Namespace("req"), zap.String("method", "GET")).Error("error", zap.String("path", "/"))
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	// the serviceContext field should not be encoded in the "req" namespace
+	want := map[string]interface{}{
+		"service":      filepath.Base(os.Args[0]),
+		"resourceType": "global",
+	}
+	if diff := cmp.Diff(want, got[serviceContextKey]); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+	wantReq := map[string]interface{}{
+		"method": "GET",
+		"path":   "/",
+	}
+	if diff := cmp.Diff(wantReq, got["req"]); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+}
@@ -6,10 +6,12 @@ package zapcl
 import (
 	"go/build"
 	"path/filepath"
+	"runtime/debug"
 	"strings"
 
 	"cloud.google.com/go/logging/apiv2/loggingpb"
 	"go.uber.org/zap"
+	"go.uber.org/zap/buffer"
 	"go.uber.org/zap/zapcore"
 )
 
@@ -62,3 +64,145 @@ func newSource(pc uintptr, file string, line int, ok bool) *sourceLocation {
 func SourceLocation(pc uintptr, file string, line int, ok bool) zapcore.Field {
 	return zap.Object(SourceLocationKey, newSource(pc, file, line, ok))
 }
+
+// sourceLocationConfig is the configuration of the automatic sourceLocation.
+type sourceLocationConfig struct {
+	level        zapcore.LevelEnabler
+	trimPrefixes []string
+	packagePath  bool
+}
+
+// SourceLocationOption configures WithSourceLocation.
+type SourceLocationOption interface {
+	applySourceLocation(*sourceLocationConfig)
+}
+
+// sourceLocationOptionFunc wraps a func so it satisfies the SourceLocationOption interface.
+type sourceLocationOptionFunc func(*sourceLocationConfig)
+
+func (f sourceLocationOptionFunc) applySourceLocation(c *sourceLocationConfig) {
+	f(c)
+}
+
+// WithSourceTrimPrefix configures to trim the first matched prefix of prefixes from the source file path.
+func WithSourceTrimPrefix(prefixes ...string) SourceLocationOption {
+	return sourceLocationOptionFunc(func(c *sourceLocationConfig) {
+		c.trimPrefixes = append(c.trimPrefixes, prefixes...)
+	})
+}
+
+// WithSourcePackagePath configures to replace the directory of the source file path with the package import path,
+// such as "github.com/zchee/zapcl/zapcl.go", regardless of where the module is placed.
+//
+// The package path of the main package is the main package path of the build information. The file path is kept
+// as is if the caller function is unknown.
+func WithSourcePackagePath() SourceLocationOption {
+	return sourceLocationOptionFunc(func(c *sourceLocationConfig) {
+		c.packagePath = true
+	})
+}
+
+// WithSourceLocation configures the Core to add the "sourceLocation" field from the entry caller to the entries
+// enabled by level, instead of the "caller" field.
+//
+// The caller is only available if the logger is configured with zap.AddCaller. The entry which already has the
+// SourceLocation field is encoded as is except for the "caller" field.
+func WithSourceLocation(level zapcore.LevelEnabler, opts ...SourceLocationOption) Option {
+	cfg := &sourceLocationConfig{
+		level: level,
+	}
+	for _, opt := range opts {
+		opt.applySourceLocation(cfg)
+	}
+
+	return optionFunc(func(c *Core) {
+		c.sourceLocation = cfg
+	})
+}
+
+// sourceLocationEncoder is the zapcore.Encoder which replaces the entry caller with the "sourceLocation" field.
+type sourceLocationEncoder struct {
+	zapcore.Encoder
+
+	cfg *sourceLocationConfig
+}
+
+// Clone implements zapcore.Encoder.
+func (e *sourceLocationEncoder) Clone() zapcore.Encoder {
+	return &sourceLocationEncoder{
+		Encoder: e.Encoder.Clone(),
+		cfg:     e.cfg,
+	}
+}
+
+// EncodeEntry implements zapcore.Encoder.
+func (e *sourceLocationEncoder) EncodeEntry(ent zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
+	if !ent.Caller.Defined || !e.cfg.level.Enabled(ent.Level) {
+		return e.Encoder.EncodeEntry(ent, fields)
+	}
+
+	caller := ent.Caller
+	ent.Caller = zapcore.EntryCaller{}
+	for i := range fields {
+		if fields[i].Key == SourceLocationKey {
+			return e.Encoder.EncodeEntry(ent, fields)
+		}
+	}
+
+	extra := make([]zapcore.Field, 0, len(fields)+1)
+	extra = append(extra, fields...)
+	extra = append(extra, zap.Object(SourceLocationKey, &sourceLocation{
+		LogEntrySourceLocation: &loggingpb.LogEntrySourceLocation{
+			File:     e.cfg.file(caller),
+			Line:     int64(caller.Line),
+			Function: caller.Function,
+		},
+	}))
+
+	return e.Encoder.EncodeEntry(ent, extra)
+}
+
+// file returns the source file path of caller.
+func (c *sourceLocationConfig) file(caller zapcore.EntryCaller) string {
+	if c.packagePath {
+		if pkg := packagePath(caller.Function); pkg != "" {
+			return pkg + "/" + filepath.Base(caller.File)
+		}
+	}
+
+	for _, prefix := range c.trimPrefixes {
+		if strings.HasPrefix(caller.File, prefix) {
+			return strings.TrimPrefix(caller.File, prefix)
+		}
+	}
+
+	return caller.File
+}
+
+// mainPackagePath is the main package path of the build information.
+var mainPackagePath = func() string {
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		return bi.Path
+	}
+
+	return ""
+}()
+
+// packagePath returns the package import path of the fully qualified function name.
+//
+// For example, "github.com/zchee/zapcl.(*Core).Write" returns "github.com/zchee/zapcl".
+func packagePath(function string) string {
+	// the package path has no '.' after the last '/'
+	lastSlash := strings.LastIndexByte(function, '/')
+	dot := strings.IndexByte(function[lastSlash+1:], '.')
+	if dot < 0 {
+		return ""
+	}
+	pkg := function[:lastSlash+1+dot]
+
+	if pkg == "main" && mainPackagePath != "" {
+		return mainPackagePath
+	}
+
+	return pkg
+}
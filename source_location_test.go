@@ -4,8 +4,16 @@
 package zapcl
 
 import (
+	"bytes"
+	"encoding/json"
+	"runtime"
 	"strings"
 	"testing"
+
+	"cloud.google.com/go/logging/apiv2/loggingpb"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 func TestSourceLocation(t *testing.T) {
@@ -39,3 +47,118 @@ func TestNewSource(t *testing.T) {
 		t.Errorf("except contains got %s in %s", gotFunc, wantFunc)
 	}
 }
+
+func TestWithSourceLocation(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := zap.New(NewCore(zapcore.AddSync(&buf), zapcore.DebugLevel, WithSourceLocation(zapcore.InfoLevel)),
+		zap.AddCaller())
+
+	logger.Debug("debug")
+	_, file, line, _ := runtime.Caller(0)
+	logger.Info("info")
+	logger.Warn("explicit", zap.Object(SourceLocationKey, &sourceLocation{
+		LogEntrySourceLocation: &loggingpb.LogEntrySourceLocation{File: "explicit.go"},
+	}))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines but want 3", len(lines))
+	}
+
+	tests := []struct {
+		wantCaller   bool
+		wantLocation interface{}
+	}{
+		{
+			wantCaller: true,
+		},
+		{
+			wantLocation: map[string]interface{}{
+				"file":     file,
+				"line":     float64(line + 1),
+				"function": "github.com/zchee/zapcl.TestWithSourceLocation",
+			},
+		},
+		{
+			wantLocation: map[string]interface{}{
+				"file":     "explicit.go",
+				"line":     float64(0),
+				"function": "",
+			},
+		},
+	}
+	for i, tt := range tests {
+		var got map[string]interface{}
+		if err := json.Unmarshal([]byte(lines[i]), &got); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := got["caller"]; ok != tt.wantCaller {
+			t.Fatalf("line %d: got %v caller but want %v", i, ok, tt.wantCaller)
+		}
+		if diff := cmp.Diff(tt.wantLocation, got[SourceLocationKey]); diff != "" {
+			t.Fatalf("line %d: (-want, +got)\n%s\n", i, diff)
+		}
+	}
+}
+
+func TestSourceLocationFile(t *testing.T) {
+	t.Parallel()
+
+	caller := zapcore.EntryCaller{
+		Defined:  true,
+		File:     "/home/user/src/zapcl/pkg/grpccl/server.go",
+		Function: "github.com/zchee/zapcl/pkg/grpccl.UnaryServerInterceptor.func1",
+	}
+
+	tests := map[string]struct {
+		opts   []SourceLocationOption
+		caller zapcore.EntryCaller
+		want   string
+	}{
+		"Default": {
+			caller: caller,
+			want:   "/home/user/src/zapcl/pkg/grpccl/server.go",
+		},
+		"TrimPrefix": {
+			opts:   []SourceLocationOption{WithSourceTrimPrefix("/nowhere/", "/home/user/src/")},
+			caller: caller,
+			want:   "zapcl/pkg/grpccl/server.go",
+		},
+		"PackagePath": {
+			opts:   []SourceLocationOption{WithSourcePackagePath()},
+			caller: caller,
+			want:   "github.com/zchee/zapcl/pkg/grpccl/server.go",
+		},
+		"PackagePathMethod": {
+			opts: []SourceLocationOption{WithSourcePackagePath()},
+			caller: zapcore.EntryCaller{
+				File:     "/src/zapcl.go",
+				Function: "github.com/zchee/zapcl.(*Core).Write",
+			},
+			want: "github.com/zchee/zapcl/zapcl.go",
+		},
+		"PackagePathUnknownFunction": {
+			opts: []SourceLocationOption{WithSourcePackagePath(), WithSourceTrimPrefix("/src/")},
+			caller: zapcore.EntryCaller{
+				File: "/src/zapcl.go",
+			},
+			want: "zapcl.go",
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := new(sourceLocationConfig)
+			for _, opt := range tt.opts {
+				opt.applySourceLocation(cfg)
+			}
+			if diff := cmp.Diff(tt.want, cfg.file(tt.caller)); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
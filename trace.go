@@ -4,6 +4,9 @@
 package zapcl
 
 import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
@@ -40,6 +43,8 @@ const (
 
 // TraceField adds the correct Cloud Logging "trace", "span", "trace_sampled" fields from ctx.
 //
+// The project ID of the trace is resolved once by monitoredresource.ResourceDetector, and cached.
+//
 // https://cloud.google.com/logging/docs/agent/logging/configuration#special-fields
 func TraceField(traceID, spanID string, isSampled bool) []zapcore.Field {
 	return []zapcore.Field{
@@ -48,3 +53,76 @@ func TraceField(traceID, spanID string, isSampled bool) []zapcore.Field {
 		zap.Bool(TraceSampledKey, isSampled),
 	}
 }
+
+// traceContextKey is the context key of the zapcl-owned traceContext.
+type traceContextKey struct{}
+
+// traceContext is the trace context stored by ContextWithTrace.
+type traceContext struct {
+	traceID   string
+	spanID    string
+	isSampled bool
+}
+
+// ContextWithTrace returns a copy of ctx which carries the trace.
+//
+// It is the fallback of TraceFromContext for the program which does not use OpenTelemetry.
+func ContextWithTrace(ctx context.Context, traceID, spanID string, isSampled bool) context.Context {
+	return context.WithValue(ctx, traceContextKey{}, traceContext{
+		traceID:   traceID,
+		spanID:    spanID,
+		isSampled: isSampled,
+	})
+}
+
+// TraceFromContext returns the Cloud Logging "trace", "span", "trace_sampled" fields of the active span in ctx.
+//
+// It extracts the OpenTelemetry trace.SpanContext first, and falls back to the trace stored by ContextWithTrace.
+// It returns nil if ctx carries no trace.
+func TraceFromContext(ctx context.Context) []zapcore.Field {
+	tc, ok := traceFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return TraceField(tc.traceID, tc.spanID, tc.isSampled)
+}
+
+// TraceContextFromContext returns the trace ID, span ID and sampled flag of the active span in ctx in the same order
+// as TraceFromContext. ok is false if ctx carries no trace.
+//
+// It is useful to propagate the trace to the outgoing request.
+func TraceContextFromContext(ctx context.Context) (traceID, spanID string, isSampled, ok bool) {
+	tc, ok := traceFromContext(ctx)
+
+	return tc.traceID, tc.spanID, tc.isSampled, ok
+}
+
+// traceFromContext returns the trace context of the active span in ctx.
+func traceFromContext(ctx context.Context) (traceContext, bool) {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		return traceContext{
+			traceID:   sc.TraceID().String(),
+			spanID:    sc.SpanID().String(),
+			isSampled: sc.IsSampled(),
+		}, true
+	}
+
+	if tc, ok := ctx.Value(traceContextKey{}).(traceContext); ok && tc.traceID != "" {
+		return tc, true
+	}
+
+	return traceContext{}, false
+}
+
+// WithContext returns a child logger of logger which attaches the trace fields of ctx to every entry.
+//
+// It returns logger as is if ctx carries no trace.
+func WithContext(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	fields := TraceFromContext(ctx)
+	if len(fields) == 0 {
+		return logger
+	}
+
+	return logger.With(fields...)
+}
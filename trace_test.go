@@ -0,0 +1,111 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/zchee/zapcl/pkg/detector"
+	"github.com/zchee/zapcl/pkg/monitoredresource"
+)
+
+func init() {
+	// resolve the monitored resource and the trace project by the fake attributes, instead of looking up the metadata
+	// server of the machine running the tests.
+	monitoredresource.ResourceDetector = monitoredresource.NewResource(noPlatformAttributes{})
+}
+
+// noPlatformAttributes is the detector.ResourceAttributesFetcher which is not on any platform.
+type noPlatformAttributes struct{}
+
+func (noPlatformAttributes) EnvVar(string) string                    { return "" }
+func (noPlatformAttributes) Metadata(context.Context, string) string { return "" }
+func (noPlatformAttributes) ReadAll(string) string                   { return "" }
+
+func (noPlatformAttributes) LookupMetadata(context.Context, string) (string, error) {
+	return "", detector.ErrMetadataNotDefined
+}
+
+const (
+	testTraceID = "0123456789abcdef0123456789abcdef"
+	testSpanID  = "0123456789abcdef"
+)
+
+func TestTraceFromContext(t *testing.T) {
+	t.Parallel()
+
+	traceID, err := trace.TraceIDFromHex(testTraceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spanID, err := trace.SpanIDFromHex(testSpanID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otelCtx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	tests := map[string]struct {
+		ctx  context.Context
+		want []zapcore.Field
+	}{
+		"Empty": {
+			ctx:  context.Background(),
+			want: nil,
+		},
+		"OpenTelemetry": {
+			ctx:  otelCtx,
+			want: TraceField(testTraceID, testSpanID, true),
+		},
+		"ContextWithTrace": {
+			ctx:  ContextWithTrace(context.Background(), testTraceID, testSpanID, false),
+			want: TraceField(testTraceID, testSpanID, false),
+		},
+		"OpenTelemetryFirst": {
+			ctx:  ContextWithTrace(otelCtx, "other", "other", false),
+			want: TraceField(testTraceID, testSpanID, true),
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(tt.want, TraceFromContext(tt.ctx)); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	t.Parallel()
+
+	logger := zap.NewNop()
+	if got := WithContext(context.Background(), logger); got != logger {
+		t.Fatal("WithContext should return logger as is if ctx carries no trace")
+	}
+
+	var buf bytes.Buffer
+	enc := zapcore.NewJSONEncoder(zapcore.EncoderConfig{MessageKey: "message"})
+	logger = zap.New(zapcore.NewCore(enc, zapcore.AddSync(&buf), zapcore.DebugLevel))
+	ctx := ContextWithTrace(context.Background(), testTraceID, testSpanID, true)
+	WithContext(ctx, logger).Info("hello")
+
+	want := `{"message":"hello","logging.googleapis.com/trace":"projects//traces/` + testTraceID + `",` +
+		`"logging.googleapis.com/spanId":"` + testSpanID + `","logging.googleapis.com/trace_sampled":true}` + "\n"
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+}
@@ -5,9 +5,9 @@
 package zapcl
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"io"
 	"sort"
 
 	"go.uber.org/zap"
@@ -51,33 +51,40 @@ func levelEncoder(lvl zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
 	enc.AppendString(levelToSeverity[lvl].Enum().String())
 }
 
-type nopWriteSyncer struct {
-	io.Writer
-}
-
-func (nopWriteSyncer) Sync() error { return nil }
-
 // Core represents a zapcor.Core that is Cloud Logging integration for Zap logger.
+//
+// The resource fields, the initial fields and the fields added by With are encoded once into the prefix of the
+// entries held by the encoder, so that Write never mutates the Core and is safe for concurrent use.
+//
+// The Core created by WrapCore without WithWriteSyncer writes the entries to the wrapped zapcore.Core instead.
 type Core struct {
 	zapcore.LevelEnabler
 
 	enc        zapcore.Encoder
 	ws         zapcore.WriteSyncer
+	next       zapcore.Core
 	initFields map[string]interface{}
-	fields     []zapcore.Field
+
+	labels            *labelMap
+	sourceLocation    *sourceLocationConfig
+	serviceContext    bool
+	errorReport       bool
+	res               *monitoredresource.MonitoredResource
+	logID             string
+	detectResource    func(context.Context) (*monitoredresource.MonitoredResource, error)
+	resourceErrorHook func(error)
 }
 
 var _ zapcore.Core = (*Core)(nil)
 
 func (c *Core) clone() *Core {
-	newCore := &Core{
-		fields: make([]zapcore.Field, len(c.fields)),
-		enc:    c.enc.Clone(),
-		ws:     c.ws,
+	return &Core{
+		LevelEnabler: c.LevelEnabler,
+		enc:          c.enc.Clone(),
+		ws:           c.ws,
+		next:         c.next,
+		labels:       c.labels,
 	}
-	copy(newCore.fields, c.fields)
-
-	return newCore
 }
 
 func addFields(enc zapcore.ObjectEncoder, fields []zapcore.Field) {
@@ -91,6 +98,19 @@ func addFields(enc zapcore.ObjectEncoder, fields []zapcore.Field) {
 // With implements zapcore.Core.With.
 func (c *Core) With(fields []zapcore.Field) zapcore.Core {
 	clone := c.clone()
+	if clone.next != nil {
+		// the labels are held by the Core and merged with the labels of the log site when the entry is written
+		rest := make([]zapcore.Field, 0, len(fields))
+		for i := range fields {
+			if labels, ok := labelsFromField(fields[i]); ok {
+				clone.labels = clone.labels.merge(labels)
+				continue
+			}
+			rest = append(rest, fields[i])
+		}
+		clone.next = clone.next.With(rest)
+		return clone
+	}
 	addFields(clone.enc, fields)
 
 	return clone
@@ -101,8 +121,27 @@ func (c *Core) With(fields []zapcore.Field) zapcore.Core {
 // should be logged, the Core adds itself to the CheckedEntry and returns
 // the result.
 //
+// The wrapped core checks the entry by itself, so that each core of the zapcore.NewTee writes only the entries
+// enabled by its own level. The checked entry is written with the labels of the Core and the log site merged into
+// the one labels field.
+//
 // Check implements zapcore.Core.Check.
 func (c *Core) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.next != nil {
+		next := c.next.Check(ent, nil)
+		if next == nil {
+			return ce
+		}
+		cc := &checkedCore{
+			Core:   c.next,
+			next:   next,
+			labels: c.labels,
+		}
+		ce = ce.AddCore(ent, cc)
+		cc.ce = ce
+
+		return ce
+	}
 	if c.Enabled(ent.Level) {
 		return ce.AddCore(ent, c)
 	}
@@ -115,10 +154,63 @@ func (c *Core) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.Check
 //
 // Write implemenns zapcore.Core.Write.
 func (c *Core) Write(ent zapcore.Entry, fields []zapcore.Field) error {
-	for _, field := range c.fields {
-		field.AddTo(c.enc)
+	if c.next != nil {
+		if err := c.next.Write(ent, mergeLabels(c.labels, fields)); err != nil {
+			return err
+		}
+	} else if err := c.write(ent, fields); err != nil {
+		return err
+	}
+
+	if ent.Level > zapcore.ErrorLevel {
+		// Since we may be crashing the program, sync the output. Ignore Sync
+		// errors, pending a clean solution to issue #370.
+		c.Sync() //nolint:errcheck
+	}
+
+	return nil
+}
+
+// checkedCore is the zapcore.Core which writes the entry checked by the wrapped core with the merged labels.
+type checkedCore struct {
+	zapcore.Core
+
+	// ce is the entry which the checkedCore is added to, and next is the entry checked by the wrapped core
+	ce     *zapcore.CheckedEntry
+	next   *zapcore.CheckedEntry
+	labels *labelMap
+}
+
+// Write implements zapcore.Core.Write.
+func (c *checkedCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	// the logger fills the caller and the stack trace of the entry after Check, and reports the write errors to
+	// its ErrorOutput
+	c.next.Entry = ent
+	c.next.ErrorOutput = c.ce.ErrorOutput
+	c.next.Write(mergeLabels(c.labels, fields)...)
+
+	return nil
+}
+
+// mergeLabels returns fields whose labels fields are merged with labels into the one labels field.
+func mergeLabels(labels *labelMap, fields []zapcore.Field) []zapcore.Field {
+	merged := make([]zapcore.Field, 0, len(fields)+1)
+	for i := range fields {
+		if l, ok := labelsFromField(fields[i]); ok {
+			labels = labels.merge(l)
+			continue
+		}
+		merged = append(merged, fields[i])
+	}
+	if labels != nil {
+		merged = append(merged, zap.Object(LabelsKey, labels))
 	}
 
+	return merged
+}
+
+// write encodes the entry by the encoder and writes it to the WriteSyncer.
+func (c *Core) write(ent zapcore.Entry, fields []zapcore.Field) error {
 	buf, err := c.enc.EncodeEntry(ent, fields)
 	if err != nil {
 		return fmt.Errorf("could not encode entry: %w", err)
@@ -130,12 +222,6 @@ func (c *Core) Write(ent zapcore.Entry, fields []zapcore.Field) error {
 		return fmt.Errorf("could not write buf: %w", err)
 	}
 
-	if ent.Level > zapcore.ErrorLevel {
-		// Since we may be crashing the program, sync the output. Ignore Sync
-		// errors, pending a clean solution to issue #370.
-		c.Sync() //nolint:errcheck
-	}
-
 	return nil
 }
 
@@ -143,7 +229,14 @@ func (c *Core) Write(ent zapcore.Entry, fields []zapcore.Field) error {
 //
 // Sync implemenns zapcore.Core.Sync.
 func (c *Core) Sync() error {
-	if err := c.ws.Sync(); err != nil {
+	var err error
+	if c.next != nil {
+		err = c.next.Sync()
+	} else {
+		err = c.ws.Sync()
+	}
+
+	if err != nil {
 		if !knownSyncError(err) {
 			return fmt.Errorf("faild to sync logger: %w", err)
 		}
@@ -205,17 +298,44 @@ func WithWriteSyncer(ws zapcore.WriteSyncer) Option {
 func newCore(ws zapcore.WriteSyncer, enab zapcore.LevelEnabler, opts ...Option) *Core {
 	core := &Core{
 		LevelEnabler: enab,
-		enc:          zapcore.NewJSONEncoder(NewEncoderConfig()),
 		ws:           ws,
 	}
 	for _, opt := range opts {
 		opt.apply(core)
 	}
 
-	res := monitoredresource.Detect()
-	core.fields = []zapcore.Field{
-		zap.String(res.Type, res.LogID),
-		zap.Inline(res),
+	// the entries are written to the WriteSyncer if WithWriteSyncer overrides the wrapped core
+	if core.ws != nil {
+		core.next = nil
+	}
+
+	core.enc = newEncoder(NewEncoderConfig())
+
+	res := core.resource()
+	logID := core.logID
+	if logID == "" {
+		logID = res.LogID
+	}
+	fields := []zapcore.Field{
+		zap.Object(ResourceKey, resourceObject{res: res, logID: logID}),
+	}
+	if core.sourceLocation != nil {
+		core.enc = &sourceLocationEncoder{
+			Encoder: core.enc,
+			cfg:     core.sourceLocation,
+		}
+	}
+	if core.serviceContext {
+		core.enc = &levelFieldEncoder{
+			Encoder: core.enc,
+			level:   zapcore.ErrorLevel,
+			field:   ServiceContextFromResource(res),
+		}
+	}
+	// the Error Reporting encoder is the outermost to take the entry caller before the sourceLocation encoder
+	// drops it
+	if core.errorReport {
+		core.enc = NewErrorReportEncoder(core.enc, zapcore.ErrorLevel)
 	}
 
 	// handling initFields option
@@ -230,24 +350,53 @@ func newCore(ws zapcore.WriteSyncer, enab zapcore.LevelEnabler, opts ...Option)
 		for _, k := range keys {
 			fs = append(fs, zap.Any(k, core.initFields[k]))
 		}
-		core.fields = append(core.fields, fs...)
+		fields = append(fields, fs...)
 	}
 
+	if core.next != nil {
+		// the labels are merged with the labels of the entry by the Core
+		core.next = core.next.With(fields)
+		return core
+	}
+	if core.labels != nil {
+		fields = append(fields, zap.Object(LabelsKey, core.labels))
+	}
+
+	// encode the resource and initial fields once, and serialize the concurrent writes
+	addFields(core.enc, fields)
+	core.ws = zapcore.Lock(core.ws)
+
 	return core
 }
 
+// wrapCore configures the Core to write the entries to next unless WithWriteSyncer is given.
+func wrapCore(next zapcore.Core) Option {
+	return optionFunc(func(c *Core) {
+		c.next = next
+	})
+}
+
 // NewCore creates a Core that writes logs to a WriteSyncer.
 func NewCore(ws zapcore.WriteSyncer, enab zapcore.LevelEnabler, opts ...Option) zapcore.Core {
-	core := newCore(ws, enab, opts...)
-
-	return zapcore.NewCore(core.enc, core.ws, core.LevelEnabler)
+	return newCore(ws, enab, opts...)
 }
 
-// WrapCore wraps or replaces the Logger's underlying zapcore.Core.
+// WrapCore wraps the Logger's underlying zapcore.Core.
+//
+// If WithWriteSyncer is given, the returned core tees the entries to the underlying core and the Core which writes
+// them to the WriteSyncer in the Cloud Logging structured logging format.
+//
+// Otherwise, the Core adds the resource fields, the initial fields and the labels of WithLabels to the underlying
+// core, and writes the entries to it in its own format. The labels of WithLabels, zap.Logger.With and the log site
+// are merged into the one labels field same as NewCore. The options which configure the encoding, such as
+// WithSourceLocation and WithErrorReport, take effect only with WithWriteSyncer.
 func WrapCore(opts ...Option) zap.Option {
 	return zap.WrapCore(func(c zapcore.Core) zapcore.Core {
-		core := newCore(nopWriteSyncer{}, c, opts...)
+		core := newCore(nil, c, append([]Option{wrapCore(c)}, opts...)...)
+		if core.next == nil {
+			return zapcore.NewTee(c, core)
+		}
 
-		return zapcore.NewCore(core.enc, core.ws, core.LevelEnabler)
+		return core
 	})
 }
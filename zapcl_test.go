@@ -0,0 +1,540 @@
+// Copyright 2023 The zapcl Authors
+// SPDX-License-Identifier: BSD-3-Clause
+
+package zapcl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"golang.org/x/sys/unix"
+	mrpb "google.golang.org/genproto/googleapis/api/monitoredres"
+
+	"github.com/zchee/zapcl/pkg/monitoredresource"
+)
+
+func TestCoreConcurrentWrite(t *testing.T) {
+	t.Parallel()
+
+	const (
+		goroutines = 16
+		writes     = 100
+	)
+
+	var buf bytes.Buffer
+	core := newCore(zapcore.AddSync(&buf), zapcore.DebugLevel,
+		WithInitialFields(map[string]interface{}{"init": "field"}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// the parent Core is shared by With and Write of the all goroutines
+			child := core.With([]zapcore.Field{zap.Int("goroutine", i), Label("worker", strconv.Itoa(i))})
+			for j := 0; j < writes; j++ {
+				ent := zapcore.Entry{Level: zapcore.InfoLevel, Message: "concurrent"}
+				if err := child.Write(ent, []zapcore.Field{zap.Int("write", j)}); err != nil {
+					t.Error(err)
+					return
+				}
+				if err := core.Write(ent, nil); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if got, want := len(lines), 2*goroutines*writes; got != want {
+		t.Fatalf("got %d lines but want %d", got, want)
+	}
+
+	counts := make(map[string]int)
+	for _, line := range lines {
+		for _, key := range []string{"init", "goroutine", "write", LabelsKey} {
+			if n := strings.Count(line, strconv.Quote(key)+":"); n > 1 {
+				t.Fatalf("got %d %s fields but want at most 1: %s", n, key, line)
+			}
+		}
+
+		var got map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatalf("%v: %s", err, line)
+		}
+		if got["init"] != "field" {
+			t.Fatalf("entry has no initial field: %s", line)
+		}
+
+		goroutine, ok := got["goroutine"].(float64)
+		if !ok {
+			continue
+		}
+		worker := got[LabelsKey].(map[string]interface{})["worker"]
+		if want := strconv.Itoa(int(goroutine)); worker != want {
+			t.Fatalf("got %v worker label but want %s: %s", worker, want, line)
+		}
+		counts[worker.(string)]++
+	}
+
+	for i := 0; i < goroutines; i++ {
+		if got := counts[strconv.Itoa(i)]; got != writes {
+			t.Fatalf("got %d entries of goroutine %d but want %d", got, i, writes)
+		}
+	}
+}
+
+func TestCoreWriteDoesNotAccumulateFields(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	core := newCore(zapcore.AddSync(&buf), zapcore.DebugLevel,
+		WithInitialFields(map[string]interface{}{"init": "field"}))
+	child := core.With([]zapcore.Field{zap.String("with", "field")})
+
+	for i := 0; i < 3; i++ {
+		if err := child.Write(zapcore.Entry{Level: zapcore.InfoLevel}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := core.Write(zapcore.Entry{Level: zapcore.InfoLevel}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	for i, line := range lines {
+		if n := strings.Count(line, `"init":`); n != 1 {
+			t.Fatalf("line %d: got %d initial fields but want 1: %s", i, n, line)
+		}
+		want := 1
+		if i == len(lines)-1 {
+			want = 0
+		}
+		if n := strings.Count(line, `"with":`); n != want {
+			t.Fatalf("line %d: got %d with fields but want %d: %s", i, n, want, line)
+		}
+	}
+}
+
+func TestCoreWithLevel(t *testing.T) {
+	t.Parallel()
+
+	core := newCore(zapcore.AddSync(io.Discard), zapcore.InfoLevel).With([]zapcore.Field{zap.String("key", "value")})
+
+	if ce := core.Check(zapcore.Entry{Level: zapcore.DebugLevel}, nil); ce != nil {
+		t.Fatal("debug entry should not be checked")
+	}
+	if ce := core.Check(zapcore.Entry{Level: zapcore.InfoLevel}, nil); ce == nil {
+		t.Fatal("info entry should be checked")
+	}
+}
+
+func TestNewCore(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	core := NewCore(zapcore.AddSync(&buf), zapcore.InfoLevel, WithInitialFields(map[string]interface{}{"init": "field"}))
+	if _, ok := core.(*Core); !ok {
+		t.Fatalf("got %T but want *Core", core)
+	}
+
+	zap.New(core).Info("hello")
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	res, ok := got[ResourceKey].(map[string]interface{})
+	if !ok || res["type"] != monitoredresource.Detect().Type {
+		t.Fatalf("entry has no resource field: %s", buf.String())
+	}
+	if got["init"] != "field" {
+		t.Fatalf("entry has no initial field: %s", buf.String())
+	}
+}
+
+func TestWrapCore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Wrap", func(t *testing.T) {
+		t.Parallel()
+
+		obs, logs := observer.New(zapcore.InfoLevel)
+		logger := zap.New(obs).WithOptions(WrapCore(
+			WithInitialFields(map[string]interface{}{"init": "field"}),
+			WithLabels("env", "test"),
+		))
+
+		logger.Debug("debug")
+		logger.With(zap.String("with", "field")).Info("hello")
+
+		entries := logs.AllUntimed()
+		if len(entries) != 1 {
+			t.Fatalf("got %d entries but want 1", len(entries))
+		}
+		fields := entries[0].ContextMap()
+		for _, key := range []string{"init", "with", LabelsKey, ResourceKey} {
+			if _, ok := fields[key]; !ok {
+				t.Fatalf("entry has no %s field: %v", key, fields)
+			}
+		}
+
+		if err := logger.Sync(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("WrapLabels", func(t *testing.T) {
+		t.Parallel()
+
+		errObs, errLogs := observer.New(zapcore.ErrorLevel)
+		debugObs, debugLogs := observer.New(zapcore.DebugLevel)
+		logger := zap.New(zapcore.NewTee(errObs, debugObs), zap.AddCaller()).WithOptions(WrapCore(
+			WithLabels("env", "test", "team", "core"),
+		))
+
+		logger.Info("info")
+		logger.With(Labels("env", "with")).Error("error", Label("component", "api"))
+
+		if got := errLogs.Len(); got != 1 {
+			t.Fatalf("got %d entries of the error level core but want 1", got)
+		}
+		if got := debugLogs.Len(); got != 2 {
+			t.Fatalf("got %d entries of the debug level core but want 2", got)
+		}
+
+		want := []map[string]interface{}{
+			{"env": "test", "team": "core"},
+			{"env": "with", "team": "core", "component": "api"},
+		}
+		for i, entry := range debugLogs.AllUntimed() {
+			n := 0
+			for _, f := range entry.Context {
+				if f.Key == LabelsKey {
+					n++
+				}
+			}
+			if n != 1 {
+				t.Fatalf("entry %d: got %d %s fields but want 1: %v", i, n, LabelsKey, entry.Context)
+			}
+			if diff := cmp.Diff(want[i], entry.ContextMap()[LabelsKey]); diff != "" {
+				t.Fatalf("entry %d: (-want, +got)\n%s\n", i, diff)
+			}
+			if !entry.Caller.Defined {
+				t.Fatalf("entry %d has no caller", i)
+			}
+		}
+	})
+
+	t.Run("WrapTee", func(t *testing.T) {
+		t.Parallel()
+
+		errObs, errLogs := observer.New(zapcore.ErrorLevel)
+		debugObs, debugLogs := observer.New(zapcore.DebugLevel)
+		logger := zap.New(zapcore.NewTee(errObs, debugObs)).WithOptions(WrapCore())
+
+		logger.Info("info")
+		logger.With(zap.String("with", "field")).Error("error")
+
+		if got := errLogs.Len(); got != 1 {
+			t.Fatalf("got %d entries of the error level core but want 1", got)
+		}
+		if got := debugLogs.Len(); got != 2 {
+			t.Fatalf("got %d entries of the debug level core but want 2", got)
+		}
+		fields := errLogs.AllUntimed()[0].ContextMap()
+		for _, key := range []string{"with", ResourceKey} {
+			if _, ok := fields[key]; !ok {
+				t.Fatalf("entry has no %s field: %v", key, fields)
+			}
+		}
+	})
+
+	t.Run("Tee", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		obs, logs := observer.New(zapcore.InfoLevel)
+		logger := zap.New(obs).WithOptions(WrapCore(WithWriteSyncer(zapcore.AddSync(&buf))))
+
+		logger.Debug("debug")
+		logger.Info("hello", zap.String(SpanKey, "span"))
+
+		if got := logs.Len(); got != 1 {
+			t.Fatalf("got %d entries of the underlying core but want 1", got)
+		}
+		if _, ok := logs.All()[0].ContextMap()[SpanKey]; !ok {
+			t.Fatal("underlying core entry has no span field")
+		}
+
+		var got map[string]interface{}
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Fatalf("%v: %s", err, buf.String())
+		}
+		if got["message"] != "hello" || got[SpanKey] != "span" {
+			t.Fatalf("unexpected Cloud Logging entry: %s", buf.String())
+		}
+	})
+}
+
+func TestCoreResource(t *testing.T) {
+	t.Parallel()
+
+	res, err := monitoredresource.NewGenericTask("test-project", "us-central1", "namespace", "job", "0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid := &monitoredresource.MonitoredResource{
+		LogID:             "invalid",
+		MonitoredResource: &mrpb.MonitoredResource{Type: "unknown"},
+	}
+	errDetect := errors.New("detect error")
+	fallback := monitoredresource.Detect()
+	labels := map[string]interface{}{
+		"project_id": "test-project",
+		"location":   "us-central1",
+		"namespace":  "namespace",
+		"job":        "job",
+		"task_id":    "0",
+	}
+
+	tests := map[string]struct {
+		opts     []Option
+		want     map[string]interface{}
+		wantHook error
+	}{
+		"MonitoredResource": {
+			opts: []Option{WithMonitoredResource(res)},
+			want: map[string]interface{}{
+				"type":   "generic_task",
+				"labels": labels,
+				"logId":  "stdout",
+			},
+		},
+		"LogID": {
+			opts: []Option{WithMonitoredResource(res), WithLogID("app"), WithResourceDetector(func(context.Context) (*monitoredresource.MonitoredResource, error) {
+				t.Error("WithMonitoredResource should take precedence over WithResourceDetector")
+				return nil, nil
+			})},
+			want: map[string]interface{}{
+				"type":   "generic_task",
+				"labels": labels,
+				"logId":  "app",
+			},
+		},
+		"InvalidResource": {
+			opts: []Option{WithMonitoredResource(invalid)},
+			want: map[string]interface{}{
+				"type":   fallback.Type,
+				"labels": map[string]interface{}{"project_id": fallback.Labels["project_id"]},
+				"logId":  fallback.LogID,
+			},
+			wantHook: monitoredresource.ErrUnknownType,
+		},
+		"InvalidResourceDetector": {
+			opts: []Option{WithMonitoredResource(invalid), WithResourceDetector(func(context.Context) (*monitoredresource.MonitoredResource, error) {
+				return res, nil
+			})},
+			want: map[string]interface{}{
+				"type":   "generic_task",
+				"labels": labels,
+				"logId":  "stdout",
+			},
+			wantHook: monitoredresource.ErrUnknownType,
+		},
+		"ResourceDetector": {
+			opts: []Option{WithResourceDetector(func(ctx context.Context) (*monitoredresource.MonitoredResource, error) {
+				if _, ok := ctx.Deadline(); !ok {
+					t.Error("detection context has no deadline")
+				}
+				return res, nil
+			})},
+			want: map[string]interface{}{
+				"type":   "generic_task",
+				"labels": labels,
+				"logId":  "stdout",
+			},
+		},
+		"ResourceDetectorError": {
+			opts: []Option{WithResourceDetector(func(context.Context) (*monitoredresource.MonitoredResource, error) {
+				return nil, errDetect
+			})},
+			want: map[string]interface{}{
+				"type":   fallback.Type,
+				"labels": map[string]interface{}{"project_id": fallback.Labels["project_id"]},
+				"logId":  fallback.LogID,
+			},
+			wantHook: errDetect,
+		},
+		"ResourceDetectorInvalid": {
+			opts: []Option{WithResourceDetector(func(context.Context) (*monitoredresource.MonitoredResource, error) {
+				return invalid, nil
+			})},
+			want: map[string]interface{}{
+				"type":   fallback.Type,
+				"labels": map[string]interface{}{"project_id": fallback.Labels["project_id"]},
+				"logId":  fallback.LogID,
+			},
+			wantHook: monitoredresource.ErrUnknownType,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var hookErr error
+			var buf bytes.Buffer
+			opts := append([]Option{WithResourceErrorHook(func(err error) { hookErr = err })}, tt.opts...)
+			zap.New(NewCore(zapcore.AddSync(&buf), zapcore.InfoLevel, opts...)).Info("hello")
+
+			if !errors.Is(hookErr, tt.wantHook) || (tt.wantHook == nil && hookErr != nil) {
+				t.Fatalf("got %v error by the hook but want %v", hookErr, tt.wantHook)
+			}
+
+			var got map[string]interface{}
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("%v: %s", err, buf.String())
+			}
+			if diff := cmp.Diff(tt.want, got[ResourceKey]); diff != "" {
+				t.Fatalf("(-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+// syncWriter is the zapcore.WriteSyncer which returns err by Sync.
+type syncWriter struct {
+	io.Writer
+
+	err    error
+	synced int
+}
+
+func (w *syncWriter) Sync() error {
+	w.synced++
+	return w.err
+}
+
+func TestCoreSync(t *testing.T) {
+	t.Parallel()
+
+	errSync := errors.New("sync error")
+	tests := map[string]struct {
+		err     error
+		wantErr error
+	}{
+		"Success": {},
+		"KnownError": {
+			err: unix.EINVAL,
+		},
+		"Error": {
+			err:     errSync,
+			wantErr: errSync,
+		},
+	}
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ws := &syncWriter{Writer: io.Discard, err: tt.err}
+			core := NewCore(ws, zapcore.DebugLevel)
+
+			if err := core.Sync(); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got %v error but want %v", err, tt.wantErr)
+			}
+
+			// the entry above ErrorLevel syncs the output
+			if err := core.Write(zapcore.Entry{Level: zapcore.DPanicLevel}, nil); err != nil {
+				t.Fatal(err)
+			}
+			if ws.synced != 2 {
+				t.Fatalf("got %d syncs but want 2", ws.synced)
+			}
+		})
+	}
+}
+
+// benchCores returns the zapcl Core and the zapcore.NewCore with the same encoder configuration for the benchmarks.
+func benchCores() []struct {
+	name string
+	core zapcore.Core
+} {
+	ws := zapcore.AddSync(io.Discard)
+
+	return []struct {
+		name string
+		core zapcore.Core
+	}{
+		{
+			name: "zapcl",
+			core: newCore(ws, zapcore.DebugLevel),
+		},
+		{
+			name: "zapcore",
+			core: zapcore.NewCore(zapcore.NewJSONEncoder(NewEncoderConfig()), zapcore.Lock(ws), zapcore.DebugLevel),
+		},
+	}
+}
+
+func BenchmarkCoreWrite(b *testing.B) {
+	ent := zapcore.Entry{
+		Level:   zapcore.InfoLevel,
+		Time:    time.Now(),
+		Message: "benchmark",
+	}
+	fields := []zapcore.Field{
+		zap.String("string", "value"),
+		zap.Int("int", 1),
+		zap.String(SpanKey, "00f067aa0ba902b7"),
+	}
+
+	for _, bc := range benchCores() {
+		core := bc.core.With([]zapcore.Field{zap.String("with", "value"), zap.Bool(TraceSampledKey, true)})
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if err := core.Write(ent, fields); err != nil {
+						b.Error(err)
+					}
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkCoreWith(b *testing.B) {
+	fields := []zapcore.Field{
+		zap.String("string", "value"),
+		zap.Int("int", 1),
+	}
+
+	for _, bc := range benchCores() {
+		core := bc.core
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					core.With(fields)
+				}
+			})
+		})
+	}
+}